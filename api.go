@@ -18,9 +18,10 @@
 //
 // # Error Handling
 //
-// All validators return [*FieldError] on failure, which includes the field name
-// and a descriptive message. The [All] function collects errors into an [Errors]
-// slice, while [First] returns only the first error encountered.
+// All validators return [*FieldError] on failure, which includes the field name,
+// a descriptive message, a stable code naming the validator, and its parameters.
+// The [All] function collects errors into an [Errors] slice, while [First]
+// returns only the first error encountered.
 //
 //	err := check.All(
 //	    check.Required(name, "name"),
@@ -57,6 +58,57 @@
 // Comparison validators: [Equal], [NotEqual], [EqualField], [GreaterThanField],
 // and more.
 //
+// Conditional validators: [RequiredIf], [RequiredUnless], [RequiredWith],
+// [RequiredWithAll], [RequiredWithout], [ExcludedIf], [ExcludedWith].
+//
+// Field group validators: [AtLeastOneOf], [ExactlyOneOf], [AtMostOneOf],
+// [AllOrNone].
+//
+// Combinators: [AnyOf], [ExactlyOne], [Not].
+//
+// # Localization
+//
+// Messages are rendered from a [MessageCatalog] keyed by [FieldError.MessageKey].
+// Use [NewTranslator] to register catalogs per language and [Result.Localize] to
+// rewrite a result's messages:
+//
+//	tr := check.NewTranslator().Add("fr", fr)
+//	localized := result.Localize(tr.Catalog("fr-CA"))
+//
+// # Validation Groups
+//
+// Use [Group] to scope validations to scenarios such as create and update, and
+// [AllIn] or [CheckIn] to run a chosen set of groups:
+//
+//	check.CheckIn[User]([]string{"update"},
+//	    check.Group("update", check.Str(u.ID, "id").Required().V()),
+//	)
+//
+// # Tag Interpreter
+//
+// [FromTags] runs the rules in a type's validate tags directly, as an opt-in
+// fallback for types without hand-written validation:
+//
+//	result := check.FromTags(req)
+//
+// # JSON Schema
+//
+// [SchemaFor] derives a JSON Schema from a type's validate tags and from a
+// dry run of its validators, whose parameters [Result.AppliedValidators]
+// exposes:
+//
+//	schema := check.SchemaFor[Signup](Signup{}.Validate())
+//
+// # OpenAPI
+//
+// [OpenAPIComponents] collects such schemas for the components section of an
+// OpenAPI 3.1 document, with required fields, nullable pointers, $ref links
+// between components and descriptions from the validators' messages:
+//
+//	c := check.NewOpenAPIComponents()
+//	check.AddComponent[Signup](c, Signup{}.Validate())
+//	doc, err := c.YAML()
+//
 // # Optional Field Validation
 //
 // Use [NilOr] to validate pointer fields only when present:
@@ -3,6 +3,9 @@ package check
 import (
 	"fmt"
 	"regexp"
+	"slices"
+	"strings"
+	"time"
 
 	"golang.org/x/exp/constraints"
 )
@@ -19,18 +22,23 @@ func combine(field string, validations []*Validation) *Validation {
 
 	var errs Errors
 	var validators []string
+	var params []map[string]any
+	var nested []*Validation
 
 	for _, v := range validations {
 		if v == nil {
 			continue
 		}
+		v.resolve()
 		validators = append(validators, v.validators...)
+		params = append(params, v.alignedParams()...)
+		nested = append(nested, v.nested...)
 		if v.err != nil {
 			errs = append(errs, v.err)
 		}
 	}
 
-	if len(validators) == 0 {
+	if len(validators) == 0 && len(nested) == 0 && len(errs) == 0 {
 		return nil
 	}
 
@@ -41,7 +49,85 @@ func combine(field string, validations []*Validation) *Validation {
 		err = errs
 	}
 
-	return &Validation{err: err, field: field, validators: validators}
+	return &Validation{err: err, field: field, validators: validators, params: params, nested: nested}
+}
+
+// rule is a builder step recorded for evaluation by V.
+// validators are the names the step declares, reported as applied
+// when the step is skipped by Bail.
+type rule struct {
+	validators []string
+	eval       func() *Validation
+}
+
+// evaluate returns a Validation that runs rules in order when first inspected.
+// With bail, rules after the first failure are not run; their declared
+// validators are still recorded as applied.
+//
+// The rules are copied, so steps and modifiers added to the builder later do
+// not change the returned Validation. It is nil only when there are no rules:
+// rules that record nothing, such as a Custom step returning nil, still give a
+// non-nil Validation, since they are not run until it is inspected.
+func evaluate(field string, rules []rule, bail bool) *Validation {
+	if len(rules) == 0 {
+		return nil
+	}
+	rules = slices.Clone(rules)
+	return deferred(func() *Validation {
+		validations := make([]*Validation, 0, len(rules))
+		failed := false
+		for _, r := range rules {
+			if bail && failed {
+				validations = append(validations, validation(nil, field, r.validators...))
+				continue
+			}
+			v := r.eval()
+			failed = v.Failed()
+			validations = append(validations, v)
+		}
+		return combine(field, validations)
+	})
+}
+
+// declaredName joins the validators declared by rules with "+", like the
+// names recorded by [AnyOf] and [Not] once the rules are evaluated.
+func declaredName(rules []rule) string {
+	var names []string
+	for _, r := range rules {
+		names = append(names, r.validators...)
+	}
+	return strings.Join(names, "+")
+}
+
+// declared returns rules that record the validators rules declare without
+// running them, for steps skipped because an optional value is nil or a When
+// condition is false. [Check] then still sees the rules as applied.
+func declared(field string, rules []rule) []rule {
+	skipped := make([]rule, len(rules))
+	for i, r := range rules {
+		skipped[i] = rule{validators: r.validators, eval: func() *Validation { return validation(nil, field, r.validators...) }}
+	}
+	return skipped
+}
+
+// wrapLast applies wrap to the result of the most recently recorded rule.
+func wrapLast(rules []rule, wrap func(*Validation) *Validation) []rule {
+	n := len(rules)
+	if n == 0 {
+		return rules
+	}
+	eval := rules[n-1].eval
+	rules[n-1].eval = func() *Validation { return wrap(eval()) }
+	return rules
+}
+
+// predicate runs a named predicate for the builders' Check methods.
+func predicate[T any](v T, field, name string, fn func(T) bool, message string) *Validation {
+	var err error
+	if !fn(v) {
+		err = fieldErr(field, message)
+	}
+	return validation(err, field, name)
 }
 
 // -----------------------------------------------------------------------------
@@ -50,9 +136,10 @@ func combine(field string, validations []*Validation) *Validation {
 
 // StrBuilder provides fluent validation for string values.
 type StrBuilder struct {
-	value       string
-	field       string
-	validations []*Validation
+	value string
+	field string
+	rules []rule
+	bail  bool
 }
 
 // Str creates a new string validation builder.
@@ -62,374 +149,438 @@ func Str(v string, field string) *StrBuilder {
 
 // V returns the combined validation result.
 func (b *StrBuilder) V() *Validation {
-	return combine(b.field, b.validations)
+	return evaluate(b.field, b.rules, b.bail)
 }
 
-// When conditionally applies validations.
+// When conditionally applies validations. If cond is false, the steps fn adds
+// are not run but are still recorded as applied.
 func (b *StrBuilder) When(cond bool, fn func(*StrBuilder)) *StrBuilder {
 	if cond {
 		fn(b)
+		return b
 	}
+	skipped := &StrBuilder{value: b.value, field: b.field}
+	fn(skipped)
+	b.rules = append(b.rules, declared(b.field, skipped.rules)...)
+	return b
+}
+
+// Bail stops reporting errors after the first failed step, so an empty value
+// reports only "is required". Later steps are still recorded as applied.
+func (b *StrBuilder) Bail() *StrBuilder {
+	b.bail = true
+	return b
+}
+
+// Check validates the value with a predicate, failing with message when it returns false.
+// The validator is tracked as name.
+func (b *StrBuilder) Check(name string, fn func(v string) bool, message string) *StrBuilder {
+	b.rules = append(b.rules, rule{validators: []string{name}, eval: func() *Validation { return predicate(b.value, b.field, name, fn, message) }})
+	return b
+}
+
+// Custom applies a validation function to the value and field name.
+func (b *StrBuilder) Custom(fn func(v string, field string) *Validation) *StrBuilder {
+	b.rules = append(b.rules, rule{eval: func() *Validation { return fn(b.value, b.field) }})
+	return b
+}
+
+// Either passes if the steps added by first or those added by second pass.
+// See [AnyOf].
+//
+//	check.Str(id, "id").Either(
+//	    func(b *check.StrBuilder) { b.UUID() },
+//	    func(b *check.StrBuilder) { b.Slug() },
+//	).V()
+func (b *StrBuilder) Either(first, second func(*StrBuilder)) *StrBuilder {
+	x, y := Str(b.value, b.field), Str(b.value, b.field)
+	first(x)
+	second(y)
+	name := declaredName(x.rules) + "|" + declaredName(y.rules)
+	b.rules = append(b.rules, rule{validators: []string{name}, eval: func() *Validation { return AnyOf(b.field, x.V(), y.V()) }})
+	return b
+}
+
+// Not fails with message if the steps added by fn pass. See [Not].
+func (b *StrBuilder) Not(fn func(*StrBuilder), message string) *StrBuilder {
+	x := Str(b.value, b.field)
+	fn(x)
+	name := "not:" + declaredName(x.rules)
+	b.rules = append(b.rules, rule{validators: []string{name}, eval: func() *Validation { return Not(x.V(), message) }})
+	return b
+}
+
+// Msg replaces the error message of the most recently added validation.
+// See [WithMessage].
+func (b *StrBuilder) Msg(message string) *StrBuilder {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithMessage(v, message) })
+	return b
+}
+
+// Code replaces the error code of the most recently added validation.
+// See [WithCode].
+func (b *StrBuilder) Code(code string) *StrBuilder {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithCode(v, code) })
 	return b
 }
 
 // Required validates that the string is not empty.
 func (b *StrBuilder) Required() *StrBuilder {
-	b.validations = append(b.validations, Required(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"required"}, eval: func() *Validation { return Required(b.value, b.field) }})
 	return b
 }
 
 // NotBlank validates that the string is not empty or whitespace-only.
 func (b *StrBuilder) NotBlank() *StrBuilder {
-	b.validations = append(b.validations, NotBlank(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"required"}, eval: func() *Validation { return NotBlank(b.value, b.field) }})
 	return b
 }
 
 // MinLen validates minimum string length.
 func (b *StrBuilder) MinLen(n int) *StrBuilder {
-	b.validations = append(b.validations, MinLen(b.value, n, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"min"}, eval: func() *Validation { return MinLen(b.value, n, b.field) }})
 	return b
 }
 
 // MaxLen validates maximum string length.
 func (b *StrBuilder) MaxLen(n int) *StrBuilder {
-	b.validations = append(b.validations, MaxLen(b.value, n, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"max"}, eval: func() *Validation { return MaxLen(b.value, n, b.field) }})
 	return b
 }
 
 // Len validates exact string length.
 func (b *StrBuilder) Len(n int) *StrBuilder {
-	b.validations = append(b.validations, Len(b.value, n, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"len"}, eval: func() *Validation { return Len(b.value, n, b.field) }})
 	return b
 }
 
 // LenBetween validates string length is within a range.
 func (b *StrBuilder) LenBetween(minLen, maxLen int) *StrBuilder {
-	b.validations = append(b.validations, LenBetween(b.value, minLen, maxLen, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"min", "max"}, eval: func() *Validation { return LenBetween(b.value, minLen, maxLen, b.field) }})
 	return b
 }
 
 // Match validates that the string matches a pattern.
 func (b *StrBuilder) Match(pattern *regexp.Regexp) *StrBuilder {
-	b.validations = append(b.validations, Match(b.value, pattern, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"pattern"}, eval: func() *Validation { return Match(b.value, pattern, b.field) }})
 	return b
 }
 
 // NotMatch validates that the string does not match a pattern.
 func (b *StrBuilder) NotMatch(pattern *regexp.Regexp) *StrBuilder {
-	b.validations = append(b.validations, NotMatch(b.value, pattern, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"pattern"}, eval: func() *Validation { return NotMatch(b.value, pattern, b.field) }})
 	return b
 }
 
 // Prefix validates that the string starts with the given prefix.
 func (b *StrBuilder) Prefix(prefix string) *StrBuilder {
-	b.validations = append(b.validations, Prefix(b.value, prefix, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"prefix"}, eval: func() *Validation { return Prefix(b.value, prefix, b.field) }})
 	return b
 }
 
 // Suffix validates that the string ends with the given suffix.
 func (b *StrBuilder) Suffix(suffix string) *StrBuilder {
-	b.validations = append(b.validations, Suffix(b.value, suffix, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"suffix"}, eval: func() *Validation { return Suffix(b.value, suffix, b.field) }})
 	return b
 }
 
 // Contains validates that the string contains the substring.
 func (b *StrBuilder) Contains(substr string) *StrBuilder {
-	b.validations = append(b.validations, Contains(b.value, substr, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"contains"}, eval: func() *Validation { return Contains(b.value, substr, b.field) }})
 	return b
 }
 
 // NotContains validates that the string does not contain the substring.
 func (b *StrBuilder) NotContains(substr string) *StrBuilder {
-	b.validations = append(b.validations, NotContains(b.value, substr, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"excludes"}, eval: func() *Validation { return NotContains(b.value, substr, b.field) }})
 	return b
 }
 
 // OneOf validates that the string is one of the allowed values.
 func (b *StrBuilder) OneOf(allowed []string) *StrBuilder {
-	b.validations = append(b.validations, OneOf(b.value, allowed, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"oneof"}, eval: func() *Validation { return OneOf(b.value, allowed, b.field) }})
 	return b
 }
 
 // NotOneOf validates that the string is not one of the disallowed values.
 func (b *StrBuilder) NotOneOf(disallowed []string) *StrBuilder {
-	b.validations = append(b.validations, NotOneOf(b.value, disallowed, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"notoneof"}, eval: func() *Validation { return NotOneOf(b.value, disallowed, b.field) }})
 	return b
 }
 
 // Alpha validates that the string contains only ASCII letters.
 func (b *StrBuilder) Alpha() *StrBuilder {
-	b.validations = append(b.validations, Alpha(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"alpha"}, eval: func() *Validation { return Alpha(b.value, b.field) }})
 	return b
 }
 
 // AlphaNumeric validates that the string contains only ASCII letters and digits.
 func (b *StrBuilder) AlphaNumeric() *StrBuilder {
-	b.validations = append(b.validations, AlphaNumeric(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"alphanum"}, eval: func() *Validation { return AlphaNumeric(b.value, b.field) }})
 	return b
 }
 
 // Numeric validates that the string contains only digits.
 func (b *StrBuilder) Numeric() *StrBuilder {
-	b.validations = append(b.validations, Numeric(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"numeric"}, eval: func() *Validation { return Numeric(b.value, b.field) }})
 	return b
 }
 
 // AlphaUnicode validates that the string contains only Unicode letters.
 func (b *StrBuilder) AlphaUnicode() *StrBuilder {
-	b.validations = append(b.validations, AlphaUnicode(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"alpha"}, eval: func() *Validation { return AlphaUnicode(b.value, b.field) }})
 	return b
 }
 
 // AlphaNumericUnicode validates that the string contains only Unicode letters and digits.
 func (b *StrBuilder) AlphaNumericUnicode() *StrBuilder {
-	b.validations = append(b.validations, AlphaNumericUnicode(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"alphanum"}, eval: func() *Validation { return AlphaNumericUnicode(b.value, b.field) }})
 	return b
 }
 
 // ASCII validates that the string contains only ASCII characters.
 func (b *StrBuilder) ASCII() *StrBuilder {
-	b.validations = append(b.validations, ASCII(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"ascii"}, eval: func() *Validation { return ASCII(b.value, b.field) }})
 	return b
 }
 
 // PrintableASCII validates that the string contains only printable ASCII.
 func (b *StrBuilder) PrintableASCII() *StrBuilder {
-	b.validations = append(b.validations, PrintableASCII(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"ascii"}, eval: func() *Validation { return PrintableASCII(b.value, b.field) }})
 	return b
 }
 
 // LowerCase validates that the string is entirely lowercase.
 func (b *StrBuilder) LowerCase() *StrBuilder {
-	b.validations = append(b.validations, LowerCase(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"lowercase"}, eval: func() *Validation { return LowerCase(b.value, b.field) }})
 	return b
 }
 
 // UpperCase validates that the string is entirely uppercase.
 func (b *StrBuilder) UpperCase() *StrBuilder {
-	b.validations = append(b.validations, UpperCase(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"uppercase"}, eval: func() *Validation { return UpperCase(b.value, b.field) }})
 	return b
 }
 
 // NoWhitespace validates that the string contains no whitespace.
 func (b *StrBuilder) NoWhitespace() *StrBuilder {
-	b.validations = append(b.validations, NoWhitespace(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"nowhitespace"}, eval: func() *Validation { return NoWhitespace(b.value, b.field) }})
 	return b
 }
 
 // Trimmed validates that the string has no leading or trailing whitespace.
 func (b *StrBuilder) Trimmed() *StrBuilder {
-	b.validations = append(b.validations, Trimmed(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"trimmed"}, eval: func() *Validation { return Trimmed(b.value, b.field) }})
 	return b
 }
 
 // SingleLine validates that the string contains no newlines.
 func (b *StrBuilder) SingleLine() *StrBuilder {
-	b.validations = append(b.validations, SingleLine(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"singleline"}, eval: func() *Validation { return SingleLine(b.value, b.field) }})
 	return b
 }
 
 // Identifier validates that the string is a valid identifier.
 func (b *StrBuilder) Identifier() *StrBuilder {
-	b.validations = append(b.validations, Identifier(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"identifier"}, eval: func() *Validation { return Identifier(b.value, b.field) }})
 	return b
 }
 
 // Slug validates that the string is a valid URL slug.
 func (b *StrBuilder) Slug() *StrBuilder {
-	b.validations = append(b.validations, Slug(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"slug"}, eval: func() *Validation { return Slug(b.value, b.field) }})
 	return b
 }
 
 // Email validates that the string is a valid email address.
 func (b *StrBuilder) Email() *StrBuilder {
-	b.validations = append(b.validations, Email(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"email"}, eval: func() *Validation { return Email(b.value, b.field) }})
 	return b
 }
 
 // URL validates that the string is a valid URL.
 func (b *StrBuilder) URL() *StrBuilder {
-	b.validations = append(b.validations, URL(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"url"}, eval: func() *Validation { return URL(b.value, b.field) }})
 	return b
 }
 
 // URLWithScheme validates that the string is a valid URL with one of the given schemes.
 func (b *StrBuilder) URLWithScheme(schemes []string) *StrBuilder {
-	b.validations = append(b.validations, URLWithScheme(b.value, schemes, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"url"}, eval: func() *Validation { return URLWithScheme(b.value, schemes, b.field) }})
 	return b
 }
 
 // HTTPOrHTTPS validates that the string is a valid HTTP or HTTPS URL.
 func (b *StrBuilder) HTTPOrHTTPS() *StrBuilder {
-	b.validations = append(b.validations, HTTPOrHTTPS(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"url"}, eval: func() *Validation { return HTTPOrHTTPS(b.value, b.field) }})
 	return b
 }
 
 // UUID validates that the string is a valid UUID.
 func (b *StrBuilder) UUID() *StrBuilder {
-	b.validations = append(b.validations, UUID(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"uuid"}, eval: func() *Validation { return UUID(b.value, b.field) }})
 	return b
 }
 
 // UUID4 validates that the string is a valid UUID v4.
 func (b *StrBuilder) UUID4() *StrBuilder {
-	b.validations = append(b.validations, UUID4(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"uuid4"}, eval: func() *Validation { return UUID4(b.value, b.field) }})
 	return b
 }
 
 // IP validates that the string is a valid IP address.
 func (b *StrBuilder) IP() *StrBuilder {
-	b.validations = append(b.validations, IP(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"ip"}, eval: func() *Validation { return IP(b.value, b.field) }})
 	return b
 }
 
 // IPv4 validates that the string is a valid IPv4 address.
 func (b *StrBuilder) IPv4() *StrBuilder {
-	b.validations = append(b.validations, IPv4(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"ipv4"}, eval: func() *Validation { return IPv4(b.value, b.field) }})
 	return b
 }
 
 // IPv6 validates that the string is a valid IPv6 address.
 func (b *StrBuilder) IPv6() *StrBuilder {
-	b.validations = append(b.validations, IPv6(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"ipv6"}, eval: func() *Validation { return IPv6(b.value, b.field) }})
 	return b
 }
 
 // CIDR validates that the string is a valid CIDR notation.
 func (b *StrBuilder) CIDR() *StrBuilder {
-	b.validations = append(b.validations, CIDR(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"cidr"}, eval: func() *Validation { return CIDR(b.value, b.field) }})
 	return b
 }
 
 // MAC validates that the string is a valid MAC address.
 func (b *StrBuilder) MAC() *StrBuilder {
-	b.validations = append(b.validations, MAC(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"mac"}, eval: func() *Validation { return MAC(b.value, b.field) }})
 	return b
 }
 
 // Hostname validates that the string is a valid hostname.
 func (b *StrBuilder) Hostname() *StrBuilder {
-	b.validations = append(b.validations, Hostname(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"hostname"}, eval: func() *Validation { return Hostname(b.value, b.field) }})
 	return b
 }
 
 // Port validates that the string is a valid port number.
 func (b *StrBuilder) Port() *StrBuilder {
-	b.validations = append(b.validations, Port(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"port"}, eval: func() *Validation { return Port(b.value, b.field) }})
 	return b
 }
 
 // HostPort validates that the string is a valid host:port combination.
 func (b *StrBuilder) HostPort() *StrBuilder {
-	b.validations = append(b.validations, HostPort(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"hostport"}, eval: func() *Validation { return HostPort(b.value, b.field) }})
 	return b
 }
 
 // HexColor validates that the string is a valid hex color.
 func (b *StrBuilder) HexColor() *StrBuilder {
-	b.validations = append(b.validations, HexColor(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"hexcolor"}, eval: func() *Validation { return HexColor(b.value, b.field) }})
 	return b
 }
 
 // HexColorFull validates that the string is a valid 6-digit hex color.
 func (b *StrBuilder) HexColorFull() *StrBuilder {
-	b.validations = append(b.validations, HexColorFull(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"hexcolor"}, eval: func() *Validation { return HexColorFull(b.value, b.field) }})
 	return b
 }
 
 // Base64 validates that the string is valid base64.
 func (b *StrBuilder) Base64() *StrBuilder {
-	b.validations = append(b.validations, Base64(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"base64"}, eval: func() *Validation { return Base64(b.value, b.field) }})
 	return b
 }
 
 // Base64URL validates that the string is valid URL-safe base64.
 func (b *StrBuilder) Base64URL() *StrBuilder {
-	b.validations = append(b.validations, Base64URL(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"base64url"}, eval: func() *Validation { return Base64URL(b.value, b.field) }})
 	return b
 }
 
 // JSON validates that the string is valid JSON.
 func (b *StrBuilder) JSON() *StrBuilder {
-	b.validations = append(b.validations, JSON(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"json"}, eval: func() *Validation { return JSON(b.value, b.field) }})
 	return b
 }
 
 // Semver validates that the string is a valid semantic version.
 func (b *StrBuilder) Semver() *StrBuilder {
-	b.validations = append(b.validations, Semver(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"semver"}, eval: func() *Validation { return Semver(b.value, b.field) }})
 	return b
 }
 
 // E164 validates that the string is a valid E.164 phone number.
 func (b *StrBuilder) E164() *StrBuilder {
-	b.validations = append(b.validations, E164(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"e164"}, eval: func() *Validation { return E164(b.value, b.field) }})
 	return b
 }
 
 // CreditCard validates that the string is a valid credit card number.
 func (b *StrBuilder) CreditCard() *StrBuilder {
-	b.validations = append(b.validations, CreditCard(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"creditcard"}, eval: func() *Validation { return CreditCard(b.value, b.field) }})
 	return b
 }
 
 // Latitude validates that the string is a valid latitude.
 func (b *StrBuilder) Latitude() *StrBuilder {
-	b.validations = append(b.validations, Latitude(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"latitude"}, eval: func() *Validation { return Latitude(b.value, b.field) }})
 	return b
 }
 
 // Longitude validates that the string is a valid longitude.
 func (b *StrBuilder) Longitude() *StrBuilder {
-	b.validations = append(b.validations, Longitude(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"longitude"}, eval: func() *Validation { return Longitude(b.value, b.field) }})
 	return b
 }
 
 // CountryCode2 validates that the string is a valid ISO 3166-1 alpha-2 country code.
 func (b *StrBuilder) CountryCode2() *StrBuilder {
-	b.validations = append(b.validations, CountryCode2(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"iso3166_1_alpha2"}, eval: func() *Validation { return CountryCode2(b.value, b.field) }})
 	return b
 }
 
 // CountryCode3 validates that the string is a valid ISO 3166-1 alpha-3 country code.
 func (b *StrBuilder) CountryCode3() *StrBuilder {
-	b.validations = append(b.validations, CountryCode3(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"iso3166_1_alpha3"}, eval: func() *Validation { return CountryCode3(b.value, b.field) }})
 	return b
 }
 
 // LanguageCode validates that the string is a valid ISO 639-1 language code.
 func (b *StrBuilder) LanguageCode() *StrBuilder {
-	b.validations = append(b.validations, LanguageCode(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"iso639_1"}, eval: func() *Validation { return LanguageCode(b.value, b.field) }})
 	return b
 }
 
 // CurrencyCode validates that the string is a valid ISO 4217 currency code.
 func (b *StrBuilder) CurrencyCode() *StrBuilder {
-	b.validations = append(b.validations, CurrencyCode(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"iso4217"}, eval: func() *Validation { return CurrencyCode(b.value, b.field) }})
 	return b
 }
 
 // Hex validates that the string contains only hexadecimal characters.
 func (b *StrBuilder) Hex() *StrBuilder {
-	b.validations = append(b.validations, Hex(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"hex"}, eval: func() *Validation { return Hex(b.value, b.field) }})
 	return b
 }
 
 // DataURI validates that the string is a valid data URI.
 func (b *StrBuilder) DataURI() *StrBuilder {
-	b.validations = append(b.validations, DataURI(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"datauri"}, eval: func() *Validation { return DataURI(b.value, b.field) }})
 	return b
 }
 
 // FilePath validates that the string is a valid file path.
 func (b *StrBuilder) FilePath() *StrBuilder {
-	b.validations = append(b.validations, FilePath(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"filepath"}, eval: func() *Validation { return FilePath(b.value, b.field) }})
 	return b
 }
 
 // UnixPath validates that the string is a valid Unix path.
 func (b *StrBuilder) UnixPath() *StrBuilder {
-	b.validations = append(b.validations, UnixPath(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"unixpath"}, eval: func() *Validation { return UnixPath(b.value, b.field) }})
 	return b
 }
 
@@ -439,224 +590,229 @@ func (b *StrBuilder) UnixPath() *StrBuilder {
 
 // OptStrBuilder provides fluent validation for optional string pointers.
 type OptStrBuilder struct {
-	value       *string
-	field       string
-	validations []*Validation
-	skip        bool
+	value *string
+	field string
+	rules []rule
+	bail  bool
+	skip  bool
 }
 
 // OptStr creates a new optional string validation builder.
-// If the pointer is nil, all validations are skipped (field is optional).
+// If the pointer is nil, validations are skipped but still recorded as applied.
 func OptStr(v *string, field string) *OptStrBuilder {
 	return &OptStrBuilder{value: v, field: field, skip: v == nil}
 }
 
 // V returns the combined validation result.
-// Returns nil if the value is nil (optional field not provided).
+// If the value is nil, it passes and records the declared validators.
 func (b *OptStrBuilder) V() *Validation {
 	if b.skip {
-		return nil
+		return evaluate(b.field, declared(b.field, b.rules), false)
 	}
-	return combine(b.field, b.validations)
+	return evaluate(b.field, b.rules, b.bail)
 }
 
-// When conditionally applies validations.
+// When conditionally applies validations. If cond is false, the steps fn adds
+// are not run but are still recorded as applied.
 func (b *OptStrBuilder) When(cond bool, fn func(*OptStrBuilder)) *OptStrBuilder {
-	if cond && !b.skip {
+	if cond {
 		fn(b)
+		return b
 	}
+	skipped := &OptStrBuilder{value: b.value, field: b.field, skip: b.skip}
+	fn(skipped)
+	b.rules = append(b.rules, declared(b.field, skipped.rules)...)
+	return b
+}
+
+// Bail stops reporting errors after the first failed step, so an empty value
+// reports only "is required". Later steps are still recorded as applied.
+func (b *OptStrBuilder) Bail() *OptStrBuilder {
+	b.bail = true
+	return b
+}
+
+// Check validates the value with a predicate, failing with message when it returns false.
+// The validator is tracked as name.
+func (b *OptStrBuilder) Check(name string, fn func(v string) bool, message string) *OptStrBuilder {
+	b.rules = append(b.rules, rule{validators: []string{name}, eval: func() *Validation { return predicate(*b.value, b.field, name, fn, message) }})
+	return b
+}
+
+// Custom applies a validation function to the value and field name.
+func (b *OptStrBuilder) Custom(fn func(v string, field string) *Validation) *OptStrBuilder {
+	b.rules = append(b.rules, rule{eval: func() *Validation { return fn(*b.value, b.field) }})
+	return b
+}
+
+// Either passes if the steps added by first or those added by second pass.
+// See [AnyOf].
+func (b *OptStrBuilder) Either(first, second func(*StrBuilder)) *OptStrBuilder {
+	b.rules = append(b.rules, Str(Deref(b.value), b.field).Either(first, second).rules...)
+	return b
+}
+
+// Not fails with message if the steps added by fn pass. See [Not].
+func (b *OptStrBuilder) Not(fn func(*StrBuilder), message string) *OptStrBuilder {
+	b.rules = append(b.rules, Str(Deref(b.value), b.field).Not(fn, message).rules...)
+	return b
+}
+
+// Msg replaces the error message of the most recently added validation.
+// See [WithMessage].
+func (b *OptStrBuilder) Msg(message string) *OptStrBuilder {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithMessage(v, message) })
+	return b
+}
+
+// Code replaces the error code of the most recently added validation.
+// See [WithCode].
+func (b *OptStrBuilder) Code(code string) *OptStrBuilder {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithCode(v, code) })
 	return b
 }
 
 // MinLen validates minimum string length.
 func (b *OptStrBuilder) MinLen(n int) *OptStrBuilder {
-	if !b.skip {
-		b.validations = append(b.validations, MinLen(*b.value, n, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"min"}, eval: func() *Validation { return MinLen(*b.value, n, b.field) }})
 	return b
 }
 
 // MaxLen validates maximum string length.
 func (b *OptStrBuilder) MaxLen(n int) *OptStrBuilder {
-	if !b.skip {
-		b.validations = append(b.validations, MaxLen(*b.value, n, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"max"}, eval: func() *Validation { return MaxLen(*b.value, n, b.field) }})
 	return b
 }
 
 // Len validates exact string length.
 func (b *OptStrBuilder) Len(n int) *OptStrBuilder {
-	if !b.skip {
-		b.validations = append(b.validations, Len(*b.value, n, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"len"}, eval: func() *Validation { return Len(*b.value, n, b.field) }})
 	return b
 }
 
 // LenBetween validates string length is within a range.
 func (b *OptStrBuilder) LenBetween(minLen, maxLen int) *OptStrBuilder {
-	if !b.skip {
-		b.validations = append(b.validations, LenBetween(*b.value, minLen, maxLen, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"min", "max"}, eval: func() *Validation { return LenBetween(*b.value, minLen, maxLen, b.field) }})
 	return b
 }
 
 // Match validates that the string matches a pattern.
 func (b *OptStrBuilder) Match(pattern *regexp.Regexp) *OptStrBuilder {
-	if !b.skip {
-		b.validations = append(b.validations, Match(*b.value, pattern, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"pattern"}, eval: func() *Validation { return Match(*b.value, pattern, b.field) }})
 	return b
 }
 
 // NotMatch validates that the string does not match a pattern.
 func (b *OptStrBuilder) NotMatch(pattern *regexp.Regexp) *OptStrBuilder {
-	if !b.skip {
-		b.validations = append(b.validations, NotMatch(*b.value, pattern, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"pattern"}, eval: func() *Validation { return NotMatch(*b.value, pattern, b.field) }})
 	return b
 }
 
 // Prefix validates that the string starts with the given prefix.
 func (b *OptStrBuilder) Prefix(prefix string) *OptStrBuilder {
-	if !b.skip {
-		b.validations = append(b.validations, Prefix(*b.value, prefix, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"prefix"}, eval: func() *Validation { return Prefix(*b.value, prefix, b.field) }})
 	return b
 }
 
 // Suffix validates that the string ends with the given suffix.
 func (b *OptStrBuilder) Suffix(suffix string) *OptStrBuilder {
-	if !b.skip {
-		b.validations = append(b.validations, Suffix(*b.value, suffix, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"suffix"}, eval: func() *Validation { return Suffix(*b.value, suffix, b.field) }})
 	return b
 }
 
 // Contains validates that the string contains the substring.
 func (b *OptStrBuilder) Contains(substr string) *OptStrBuilder {
-	if !b.skip {
-		b.validations = append(b.validations, Contains(*b.value, substr, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"contains"}, eval: func() *Validation { return Contains(*b.value, substr, b.field) }})
 	return b
 }
 
 // NotContains validates that the string does not contain the substring.
 func (b *OptStrBuilder) NotContains(substr string) *OptStrBuilder {
-	if !b.skip {
-		b.validations = append(b.validations, NotContains(*b.value, substr, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"excludes"}, eval: func() *Validation { return NotContains(*b.value, substr, b.field) }})
 	return b
 }
 
 // OneOf validates that the string is one of the allowed values.
 func (b *OptStrBuilder) OneOf(allowed []string) *OptStrBuilder {
-	if !b.skip {
-		b.validations = append(b.validations, OneOf(*b.value, allowed, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"oneof"}, eval: func() *Validation { return OneOf(*b.value, allowed, b.field) }})
 	return b
 }
 
 // NotOneOf validates that the string is not one of the disallowed values.
 func (b *OptStrBuilder) NotOneOf(disallowed []string) *OptStrBuilder {
-	if !b.skip {
-		b.validations = append(b.validations, NotOneOf(*b.value, disallowed, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"notoneof"}, eval: func() *Validation { return NotOneOf(*b.value, disallowed, b.field) }})
 	return b
 }
 
 // Alpha validates that the string contains only ASCII letters.
 func (b *OptStrBuilder) Alpha() *OptStrBuilder {
-	if !b.skip {
-		b.validations = append(b.validations, Alpha(*b.value, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"alpha"}, eval: func() *Validation { return Alpha(*b.value, b.field) }})
 	return b
 }
 
 // AlphaNumeric validates that the string contains only ASCII letters and digits.
 func (b *OptStrBuilder) AlphaNumeric() *OptStrBuilder {
-	if !b.skip {
-		b.validations = append(b.validations, AlphaNumeric(*b.value, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"alphanum"}, eval: func() *Validation { return AlphaNumeric(*b.value, b.field) }})
 	return b
 }
 
 // Numeric validates that the string contains only digits.
 func (b *OptStrBuilder) Numeric() *OptStrBuilder {
-	if !b.skip {
-		b.validations = append(b.validations, Numeric(*b.value, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"numeric"}, eval: func() *Validation { return Numeric(*b.value, b.field) }})
 	return b
 }
 
 // LowerCase validates that the string is entirely lowercase.
 func (b *OptStrBuilder) LowerCase() *OptStrBuilder {
-	if !b.skip {
-		b.validations = append(b.validations, LowerCase(*b.value, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"lowercase"}, eval: func() *Validation { return LowerCase(*b.value, b.field) }})
 	return b
 }
 
 // UpperCase validates that the string is entirely uppercase.
 func (b *OptStrBuilder) UpperCase() *OptStrBuilder {
-	if !b.skip {
-		b.validations = append(b.validations, UpperCase(*b.value, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"uppercase"}, eval: func() *Validation { return UpperCase(*b.value, b.field) }})
 	return b
 }
 
 // Trimmed validates that the string has no leading or trailing whitespace.
 func (b *OptStrBuilder) Trimmed() *OptStrBuilder {
-	if !b.skip {
-		b.validations = append(b.validations, Trimmed(*b.value, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"trimmed"}, eval: func() *Validation { return Trimmed(*b.value, b.field) }})
 	return b
 }
 
 // SingleLine validates that the string contains no newlines.
 func (b *OptStrBuilder) SingleLine() *OptStrBuilder {
-	if !b.skip {
-		b.validations = append(b.validations, SingleLine(*b.value, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"singleline"}, eval: func() *Validation { return SingleLine(*b.value, b.field) }})
 	return b
 }
 
 // Slug validates that the string is a valid URL slug.
 func (b *OptStrBuilder) Slug() *OptStrBuilder {
-	if !b.skip {
-		b.validations = append(b.validations, Slug(*b.value, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"slug"}, eval: func() *Validation { return Slug(*b.value, b.field) }})
 	return b
 }
 
 // Email validates that the string is a valid email address.
 func (b *OptStrBuilder) Email() *OptStrBuilder {
-	if !b.skip {
-		b.validations = append(b.validations, Email(*b.value, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"email"}, eval: func() *Validation { return Email(*b.value, b.field) }})
 	return b
 }
 
 // URL validates that the string is a valid URL.
 func (b *OptStrBuilder) URL() *OptStrBuilder {
-	if !b.skip {
-		b.validations = append(b.validations, URL(*b.value, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"url"}, eval: func() *Validation { return URL(*b.value, b.field) }})
 	return b
 }
 
 // UUID validates that the string is a valid UUID.
 func (b *OptStrBuilder) UUID() *OptStrBuilder {
-	if !b.skip {
-		b.validations = append(b.validations, UUID(*b.value, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"uuid"}, eval: func() *Validation { return UUID(*b.value, b.field) }})
 	return b
 }
 
 // UUID4 validates that the string is a valid UUID v4.
 func (b *OptStrBuilder) UUID4() *OptStrBuilder {
-	if !b.skip {
-		b.validations = append(b.validations, UUID4(*b.value, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"uuid4"}, eval: func() *Validation { return UUID4(*b.value, b.field) }})
 	return b
 }
 
@@ -666,9 +822,10 @@ func (b *OptStrBuilder) UUID4() *OptStrBuilder {
 
 // NumBuilder provides fluent validation for numeric values.
 type NumBuilder[T constraints.Ordered] struct {
-	value       T
-	field       string
-	validations []*Validation
+	value T
+	field string
+	rules []rule
+	bail  bool
 }
 
 // Num creates a new numeric validation builder.
@@ -678,74 +835,113 @@ func Num[T constraints.Ordered](v T, field string) *NumBuilder[T] {
 
 // V returns the combined validation result.
 func (b *NumBuilder[T]) V() *Validation {
-	return combine(b.field, b.validations)
+	return evaluate(b.field, b.rules, b.bail)
 }
 
-// When conditionally applies validations.
+// When conditionally applies validations. If cond is false, the steps fn adds
+// are not run but are still recorded as applied.
 func (b *NumBuilder[T]) When(cond bool, fn func(*NumBuilder[T])) *NumBuilder[T] {
 	if cond {
 		fn(b)
+		return b
 	}
+	skipped := &NumBuilder[T]{value: b.value, field: b.field}
+	fn(skipped)
+	b.rules = append(b.rules, declared(b.field, skipped.rules)...)
+	return b
+}
+
+// Bail stops reporting errors after the first failed step, so an empty value
+// reports only "is required". Later steps are still recorded as applied.
+func (b *NumBuilder[T]) Bail() *NumBuilder[T] {
+	b.bail = true
+	return b
+}
+
+// Check validates the value with a predicate, failing with message when it returns false.
+// The validator is tracked as name.
+func (b *NumBuilder[T]) Check(name string, fn func(v T) bool, message string) *NumBuilder[T] {
+	b.rules = append(b.rules, rule{validators: []string{name}, eval: func() *Validation { return predicate(b.value, b.field, name, fn, message) }})
+	return b
+}
+
+// Custom applies a validation function to the value and field name.
+func (b *NumBuilder[T]) Custom(fn func(v T, field string) *Validation) *NumBuilder[T] {
+	b.rules = append(b.rules, rule{eval: func() *Validation { return fn(b.value, b.field) }})
+	return b
+}
+
+// Msg replaces the error message of the most recently added validation.
+// See [WithMessage].
+func (b *NumBuilder[T]) Msg(message string) *NumBuilder[T] {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithMessage(v, message) })
+	return b
+}
+
+// Code replaces the error code of the most recently added validation.
+// See [WithCode].
+func (b *NumBuilder[T]) Code(code string) *NumBuilder[T] {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithCode(v, code) })
 	return b
 }
 
 // Min validates that the value is at least the minimum.
 func (b *NumBuilder[T]) Min(minVal T) *NumBuilder[T] {
-	b.validations = append(b.validations, Min(b.value, minVal, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"min"}, eval: func() *Validation { return Min(b.value, minVal, b.field) }})
 	return b
 }
 
 // Max validates that the value is at most the maximum.
 func (b *NumBuilder[T]) Max(maxVal T) *NumBuilder[T] {
-	b.validations = append(b.validations, Max(b.value, maxVal, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"max"}, eval: func() *Validation { return Max(b.value, maxVal, b.field) }})
 	return b
 }
 
 // Between validates that the value is within a range (inclusive).
 func (b *NumBuilder[T]) Between(minVal, maxVal T) *NumBuilder[T] {
-	b.validations = append(b.validations, Between(b.value, minVal, maxVal, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"min", "max"}, eval: func() *Validation { return Between(b.value, minVal, maxVal, b.field) }})
 	return b
 }
 
 // BetweenExclusive validates that the value is within a range (exclusive).
 func (b *NumBuilder[T]) BetweenExclusive(minVal, maxVal T) *NumBuilder[T] {
-	b.validations = append(b.validations, BetweenExclusive(b.value, minVal, maxVal, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"gt", "lt"}, eval: func() *Validation { return BetweenExclusive(b.value, minVal, maxVal, b.field) }})
 	return b
 }
 
 // GreaterThan validates that the value is strictly greater than the threshold.
 func (b *NumBuilder[T]) GreaterThan(threshold T) *NumBuilder[T] {
-	b.validations = append(b.validations, GreaterThan(b.value, threshold, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"gt"}, eval: func() *Validation { return GreaterThan(b.value, threshold, b.field) }})
 	return b
 }
 
 // LessThan validates that the value is strictly less than the threshold.
 func (b *NumBuilder[T]) LessThan(threshold T) *NumBuilder[T] {
-	b.validations = append(b.validations, LessThan(b.value, threshold, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"lt"}, eval: func() *Validation { return LessThan(b.value, threshold, b.field) }})
 	return b
 }
 
 // GreaterThanOrEqual validates that the value is >= the threshold.
 func (b *NumBuilder[T]) GreaterThanOrEqual(threshold T) *NumBuilder[T] {
-	b.validations = append(b.validations, GreaterThanOrEqual(b.value, threshold, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"gte"}, eval: func() *Validation { return GreaterThanOrEqual(b.value, threshold, b.field) }})
 	return b
 }
 
 // LessThanOrEqual validates that the value is <= the threshold.
 func (b *NumBuilder[T]) LessThanOrEqual(threshold T) *NumBuilder[T] {
-	b.validations = append(b.validations, LessThanOrEqual(b.value, threshold, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"lte"}, eval: func() *Validation { return LessThanOrEqual(b.value, threshold, b.field) }})
 	return b
 }
 
 // OneOfValues validates that the value is one of the allowed values.
 func (b *NumBuilder[T]) OneOfValues(allowed []T) *NumBuilder[T] {
-	b.validations = append(b.validations, OneOfValues(b.value, allowed, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"oneof"}, eval: func() *Validation { return OneOfValues(b.value, allowed, b.field) }})
 	return b
 }
 
 // NotOneOfValues validates that the value is not one of the disallowed values.
 func (b *NumBuilder[T]) NotOneOfValues(disallowed []T) *NumBuilder[T] {
-	b.validations = append(b.validations, NotOneOfValues(b.value, disallowed, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"notoneof"}, eval: func() *Validation { return NotOneOfValues(b.value, disallowed, b.field) }})
 	return b
 }
 
@@ -755,9 +951,10 @@ func (b *NumBuilder[T]) NotOneOfValues(disallowed []T) *NumBuilder[T] {
 
 // IntBuilder provides fluent validation for integer values.
 type IntBuilder[T Integer] struct {
-	value       T
-	field       string
-	validations []*Validation
+	value T
+	field string
+	rules []rule
+	bail  bool
 }
 
 // Int creates a new integer validation builder.
@@ -767,106 +964,153 @@ func Int[T Integer](v T, field string) *IntBuilder[T] {
 
 // V returns the combined validation result.
 func (b *IntBuilder[T]) V() *Validation {
-	return combine(b.field, b.validations)
+	return evaluate(b.field, b.rules, b.bail)
 }
 
-// When conditionally applies validations.
+// When conditionally applies validations. If cond is false, the steps fn adds
+// are not run but are still recorded as applied.
 func (b *IntBuilder[T]) When(cond bool, fn func(*IntBuilder[T])) *IntBuilder[T] {
 	if cond {
 		fn(b)
+		return b
 	}
+	skipped := &IntBuilder[T]{value: b.value, field: b.field}
+	fn(skipped)
+	b.rules = append(b.rules, declared(b.field, skipped.rules)...)
+	return b
+}
+
+// Bail stops reporting errors after the first failed step, so an empty value
+// reports only "is required". Later steps are still recorded as applied.
+func (b *IntBuilder[T]) Bail() *IntBuilder[T] {
+	b.bail = true
+	return b
+}
+
+// Check validates the value with a predicate, failing with message when it returns false.
+// The validator is tracked as name.
+func (b *IntBuilder[T]) Check(name string, fn func(v T) bool, message string) *IntBuilder[T] {
+	b.rules = append(b.rules, rule{validators: []string{name}, eval: func() *Validation { return predicate(b.value, b.field, name, fn, message) }})
+	return b
+}
+
+// Custom applies a validation function to the value and field name.
+func (b *IntBuilder[T]) Custom(fn func(v T, field string) *Validation) *IntBuilder[T] {
+	b.rules = append(b.rules, rule{eval: func() *Validation { return fn(b.value, b.field) }})
+	return b
+}
+
+// Msg replaces the error message of the most recently added validation.
+// See [WithMessage].
+func (b *IntBuilder[T]) Msg(message string) *IntBuilder[T] {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithMessage(v, message) })
+	return b
+}
+
+// Code replaces the error code of the most recently added validation.
+// See [WithCode].
+func (b *IntBuilder[T]) Code(code string) *IntBuilder[T] {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithCode(v, code) })
 	return b
 }
 
 // Min validates that the value is at least the minimum.
 func (b *IntBuilder[T]) Min(minVal T) *IntBuilder[T] {
-	b.validations = append(b.validations, Min(b.value, minVal, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"min"}, eval: func() *Validation { return Min(b.value, minVal, b.field) }})
 	return b
 }
 
 // Max validates that the value is at most the maximum.
 func (b *IntBuilder[T]) Max(maxVal T) *IntBuilder[T] {
-	b.validations = append(b.validations, Max(b.value, maxVal, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"max"}, eval: func() *Validation { return Max(b.value, maxVal, b.field) }})
 	return b
 }
 
 // Between validates that the value is within a range (inclusive).
 func (b *IntBuilder[T]) Between(minVal, maxVal T) *IntBuilder[T] {
-	b.validations = append(b.validations, Between(b.value, minVal, maxVal, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"min", "max"}, eval: func() *Validation { return Between(b.value, minVal, maxVal, b.field) }})
 	return b
 }
 
 // Positive validates that the value is greater than zero.
 func (b *IntBuilder[T]) Positive() *IntBuilder[T] {
-	b.validations = append(b.validations, validation(func() error {
-		if b.value <= 0 {
-			return fieldErr(b.field, "must be positive")
-		}
-		return nil
-	}(), b.field, "gt"))
+	b.rules = append(b.rules, rule{validators: []string{"gt"}, eval: func() *Validation {
+		return validation(func() error {
+			if b.value <= 0 {
+				return fieldErr(b.field, "must be positive").withKey("gt.positive")
+			}
+			return nil
+		}(), b.field, "gt").with("threshold", 0)
+	}})
 	return b
 }
 
 // Negative validates that the value is less than zero.
 func (b *IntBuilder[T]) Negative() *IntBuilder[T] {
-	b.validations = append(b.validations, validation(func() error {
-		if b.value >= 0 {
-			return fieldErr(b.field, "must be negative")
-		}
-		return nil
-	}(), b.field, "lt"))
+	b.rules = append(b.rules, rule{validators: []string{"lt"}, eval: func() *Validation {
+		return validation(func() error {
+			if b.value >= 0 {
+				return fieldErr(b.field, "must be negative").withKey("lt.negative")
+			}
+			return nil
+		}(), b.field, "lt").with("threshold", 0)
+	}})
 	return b
 }
 
 // NonNegative validates that the value is zero or greater.
 func (b *IntBuilder[T]) NonNegative() *IntBuilder[T] {
-	b.validations = append(b.validations, validation(func() error {
-		if b.value < 0 {
-			return fieldErr(b.field, "must not be negative")
-		}
-		return nil
-	}(), b.field, "gte"))
+	b.rules = append(b.rules, rule{validators: []string{"gte"}, eval: func() *Validation {
+		return validation(func() error {
+			if b.value < 0 {
+				return fieldErr(b.field, "must not be negative").withKey("gte.nonnegative")
+			}
+			return nil
+		}(), b.field, "gte").with("threshold", 0)
+	}})
 	return b
 }
 
 // NonPositive validates that the value is zero or less.
 func (b *IntBuilder[T]) NonPositive() *IntBuilder[T] {
-	b.validations = append(b.validations, validation(func() error {
-		if b.value > 0 {
-			return fieldErr(b.field, "must not be positive")
-		}
-		return nil
-	}(), b.field, "lte"))
+	b.rules = append(b.rules, rule{validators: []string{"lte"}, eval: func() *Validation {
+		return validation(func() error {
+			if b.value > 0 {
+				return fieldErr(b.field, "must not be positive").withKey("lte.nonpositive")
+			}
+			return nil
+		}(), b.field, "lte").with("threshold", 0)
+	}})
 	return b
 }
 
 // Zero validates that the value is exactly zero.
 func (b *IntBuilder[T]) Zero() *IntBuilder[T] {
-	b.validations = append(b.validations, Zero(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"eq"}, eval: func() *Validation { return Zero(b.value, b.field) }})
 	return b
 }
 
 // NonZero validates that the value is not zero.
 func (b *IntBuilder[T]) NonZero() *IntBuilder[T] {
-	b.validations = append(b.validations, NonZero(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"ne"}, eval: func() *Validation { return NonZero(b.value, b.field) }})
 	return b
 }
 
 // MultipleOf validates that the value is a multiple of the divisor.
 func (b *IntBuilder[T]) MultipleOf(divisor T) *IntBuilder[T] {
-	b.validations = append(b.validations, MultipleOf(b.value, divisor, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"multipleof"}, eval: func() *Validation { return MultipleOf(b.value, divisor, b.field) }})
 	return b
 }
 
 // Even validates that the value is even.
 func (b *IntBuilder[T]) Even() *IntBuilder[T] {
-	b.validations = append(b.validations, Even(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"even"}, eval: func() *Validation { return Even(b.value, b.field) }})
 	return b
 }
 
 // Odd validates that the value is odd.
 func (b *IntBuilder[T]) Odd() *IntBuilder[T] {
-	b.validations = append(b.validations, Odd(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"odd"}, eval: func() *Validation { return Odd(b.value, b.field) }})
 	return b
 }
 
@@ -876,10 +1120,11 @@ func (b *IntBuilder[T]) Odd() *IntBuilder[T] {
 
 // OptNumBuilder provides fluent validation for optional numeric pointers.
 type OptNumBuilder[T constraints.Ordered] struct {
-	value       *T
-	field       string
-	validations []*Validation
-	skip        bool
+	value *T
+	field string
+	rules []rule
+	bail  bool
+	skip  bool
 }
 
 // OptNum creates a new optional numeric validation builder.
@@ -890,56 +1135,85 @@ func OptNum[T constraints.Ordered](v *T, field string) *OptNumBuilder[T] {
 // V returns the combined validation result.
 func (b *OptNumBuilder[T]) V() *Validation {
 	if b.skip {
-		return nil
+		return evaluate(b.field, declared(b.field, b.rules), false)
 	}
-	return combine(b.field, b.validations)
+	return evaluate(b.field, b.rules, b.bail)
 }
 
-// When conditionally applies validations.
+// When conditionally applies validations. If cond is false, the steps fn adds
+// are not run but are still recorded as applied.
 func (b *OptNumBuilder[T]) When(cond bool, fn func(*OptNumBuilder[T])) *OptNumBuilder[T] {
-	if cond && !b.skip {
+	if cond {
 		fn(b)
+		return b
 	}
+	skipped := &OptNumBuilder[T]{value: b.value, field: b.field, skip: b.skip}
+	fn(skipped)
+	b.rules = append(b.rules, declared(b.field, skipped.rules)...)
+	return b
+}
+
+// Bail stops reporting errors after the first failed step, so an empty value
+// reports only "is required". Later steps are still recorded as applied.
+func (b *OptNumBuilder[T]) Bail() *OptNumBuilder[T] {
+	b.bail = true
+	return b
+}
+
+// Check validates the value with a predicate, failing with message when it returns false.
+// The validator is tracked as name.
+func (b *OptNumBuilder[T]) Check(name string, fn func(v T) bool, message string) *OptNumBuilder[T] {
+	b.rules = append(b.rules, rule{validators: []string{name}, eval: func() *Validation { return predicate(*b.value, b.field, name, fn, message) }})
+	return b
+}
+
+// Custom applies a validation function to the value and field name.
+func (b *OptNumBuilder[T]) Custom(fn func(v T, field string) *Validation) *OptNumBuilder[T] {
+	b.rules = append(b.rules, rule{eval: func() *Validation { return fn(*b.value, b.field) }})
+	return b
+}
+
+// Msg replaces the error message of the most recently added validation.
+// See [WithMessage].
+func (b *OptNumBuilder[T]) Msg(message string) *OptNumBuilder[T] {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithMessage(v, message) })
+	return b
+}
+
+// Code replaces the error code of the most recently added validation.
+// See [WithCode].
+func (b *OptNumBuilder[T]) Code(code string) *OptNumBuilder[T] {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithCode(v, code) })
 	return b
 }
 
 // Min validates that the value is at least the minimum.
 func (b *OptNumBuilder[T]) Min(minVal T) *OptNumBuilder[T] {
-	if !b.skip {
-		b.validations = append(b.validations, Min(*b.value, minVal, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"min"}, eval: func() *Validation { return Min(*b.value, minVal, b.field) }})
 	return b
 }
 
 // Max validates that the value is at most the maximum.
 func (b *OptNumBuilder[T]) Max(maxVal T) *OptNumBuilder[T] {
-	if !b.skip {
-		b.validations = append(b.validations, Max(*b.value, maxVal, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"max"}, eval: func() *Validation { return Max(*b.value, maxVal, b.field) }})
 	return b
 }
 
 // Between validates that the value is within a range (inclusive).
 func (b *OptNumBuilder[T]) Between(minVal, maxVal T) *OptNumBuilder[T] {
-	if !b.skip {
-		b.validations = append(b.validations, Between(*b.value, minVal, maxVal, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"min", "max"}, eval: func() *Validation { return Between(*b.value, minVal, maxVal, b.field) }})
 	return b
 }
 
 // GreaterThan validates that the value is strictly greater than the threshold.
 func (b *OptNumBuilder[T]) GreaterThan(threshold T) *OptNumBuilder[T] {
-	if !b.skip {
-		b.validations = append(b.validations, GreaterThan(*b.value, threshold, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"gt"}, eval: func() *Validation { return GreaterThan(*b.value, threshold, b.field) }})
 	return b
 }
 
 // LessThan validates that the value is strictly less than the threshold.
 func (b *OptNumBuilder[T]) LessThan(threshold T) *OptNumBuilder[T] {
-	if !b.skip {
-		b.validations = append(b.validations, LessThan(*b.value, threshold, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"lt"}, eval: func() *Validation { return LessThan(*b.value, threshold, b.field) }})
 	return b
 }
 
@@ -949,9 +1223,10 @@ func (b *OptNumBuilder[T]) LessThan(threshold T) *OptNumBuilder[T] {
 
 // SliceBuilder provides fluent validation for slice values.
 type SliceBuilder[T any] struct {
-	value       []T
-	field       string
-	validations []*Validation
+	value []T
+	field string
+	rules []rule
+	bail  bool
 }
 
 // Slice creates a new slice validation builder.
@@ -961,50 +1236,89 @@ func Slice[T any](v []T, field string) *SliceBuilder[T] {
 
 // V returns the combined validation result.
 func (b *SliceBuilder[T]) V() *Validation {
-	return combine(b.field, b.validations)
+	return evaluate(b.field, b.rules, b.bail)
 }
 
-// When conditionally applies validations.
+// When conditionally applies validations. If cond is false, the steps fn adds
+// are not run but are still recorded as applied.
 func (b *SliceBuilder[T]) When(cond bool, fn func(*SliceBuilder[T])) *SliceBuilder[T] {
 	if cond {
 		fn(b)
+		return b
 	}
+	skipped := &SliceBuilder[T]{value: b.value, field: b.field}
+	fn(skipped)
+	b.rules = append(b.rules, declared(b.field, skipped.rules)...)
+	return b
+}
+
+// Bail stops reporting errors after the first failed step, so an empty value
+// reports only "is required". Later steps are still recorded as applied.
+func (b *SliceBuilder[T]) Bail() *SliceBuilder[T] {
+	b.bail = true
+	return b
+}
+
+// Check validates the value with a predicate, failing with message when it returns false.
+// The validator is tracked as name.
+func (b *SliceBuilder[T]) Check(name string, fn func(v []T) bool, message string) *SliceBuilder[T] {
+	b.rules = append(b.rules, rule{validators: []string{name}, eval: func() *Validation { return predicate(b.value, b.field, name, fn, message) }})
+	return b
+}
+
+// Custom applies a validation function to the value and field name.
+func (b *SliceBuilder[T]) Custom(fn func(v []T, field string) *Validation) *SliceBuilder[T] {
+	b.rules = append(b.rules, rule{eval: func() *Validation { return fn(b.value, b.field) }})
+	return b
+}
+
+// Msg replaces the error message of the most recently added validation.
+// See [WithMessage].
+func (b *SliceBuilder[T]) Msg(message string) *SliceBuilder[T] {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithMessage(v, message) })
+	return b
+}
+
+// Code replaces the error code of the most recently added validation.
+// See [WithCode].
+func (b *SliceBuilder[T]) Code(code string) *SliceBuilder[T] {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithCode(v, code) })
 	return b
 }
 
 // NotEmpty validates that the slice is not empty.
 func (b *SliceBuilder[T]) NotEmpty() *SliceBuilder[T] {
-	b.validations = append(b.validations, NotEmpty(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"required"}, eval: func() *Validation { return NotEmpty(b.value, b.field) }})
 	return b
 }
 
 // Empty validates that the slice is empty.
 func (b *SliceBuilder[T]) Empty() *SliceBuilder[T] {
-	b.validations = append(b.validations, Empty(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"empty"}, eval: func() *Validation { return Empty(b.value, b.field) }})
 	return b
 }
 
 // MinItems validates minimum slice length.
 func (b *SliceBuilder[T]) MinItems(n int) *SliceBuilder[T] {
-	b.validations = append(b.validations, MinItems(b.value, n, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"minitems"}, eval: func() *Validation { return MinItems(b.value, n, b.field) }})
 	return b
 }
 
 // MaxItems validates maximum slice length.
 func (b *SliceBuilder[T]) MaxItems(n int) *SliceBuilder[T] {
-	b.validations = append(b.validations, MaxItems(b.value, n, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"maxitems"}, eval: func() *Validation { return MaxItems(b.value, n, b.field) }})
 	return b
 }
 
 // ExactItems validates exact slice length.
 func (b *SliceBuilder[T]) ExactItems(n int) *SliceBuilder[T] {
-	b.validations = append(b.validations, ExactItems(b.value, n, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"len"}, eval: func() *Validation { return ExactItems(b.value, n, b.field) }})
 	return b
 }
 
 // ItemsBetween validates slice length is within a range.
 func (b *SliceBuilder[T]) ItemsBetween(minItems, maxItems int) *SliceBuilder[T] {
-	b.validations = append(b.validations, ItemsBetween(b.value, minItems, maxItems, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"minitems", "maxitems"}, eval: func() *Validation { return ItemsBetween(b.value, minItems, maxItems, b.field) }})
 	return b
 }
 
@@ -1018,12 +1332,16 @@ func (b *SliceBuilder[T]) Each(fn func(v T, field string) *Validation) *SliceBui
 // EachV applies a validation to each element, collecting results.
 // The function receives the element and auto-generated field name.
 func (b *SliceBuilder[T]) EachV(fn func(v T, field string) *Validation) *SliceBuilder[T] {
-	for i, item := range b.value {
-		elemField := fmt.Sprintf("%s[%d]", b.field, i)
-		if v := fn(item, elemField); v != nil {
-			b.validations = append(b.validations, v)
+	b.rules = append(b.rules, rule{eval: func() *Validation {
+		var validations []*Validation
+		for i, item := range b.value {
+			elemField := fmt.Sprintf("%s[%d]", b.field, i)
+			if v := fn(item, elemField); v != nil {
+				validations = append(validations, v)
+			}
 		}
-	}
+		return combine(b.field, validations)
+	}})
 	return b
 }
 
@@ -1033,9 +1351,10 @@ func (b *SliceBuilder[T]) EachV(fn func(v T, field string) *Validation) *SliceBu
 
 // StrSliceBuilder provides fluent validation for string slices.
 type StrSliceBuilder struct {
-	value       []string
-	field       string
-	validations []*Validation
+	value []string
+	field string
+	rules []rule
+	bail  bool
 }
 
 // StrSlice creates a new string slice validation builder.
@@ -1045,58 +1364,101 @@ func StrSlice(v []string, field string) *StrSliceBuilder {
 
 // V returns the combined validation result.
 func (b *StrSliceBuilder) V() *Validation {
-	return combine(b.field, b.validations)
+	return evaluate(b.field, b.rules, b.bail)
 }
 
-// When conditionally applies validations.
+// When conditionally applies validations. If cond is false, the steps fn adds
+// are not run but are still recorded as applied.
 func (b *StrSliceBuilder) When(cond bool, fn func(*StrSliceBuilder)) *StrSliceBuilder {
 	if cond {
 		fn(b)
+		return b
 	}
+	skipped := &StrSliceBuilder{value: b.value, field: b.field}
+	fn(skipped)
+	b.rules = append(b.rules, declared(b.field, skipped.rules)...)
+	return b
+}
+
+// Bail stops reporting errors after the first failed step, so an empty value
+// reports only "is required". Later steps are still recorded as applied.
+func (b *StrSliceBuilder) Bail() *StrSliceBuilder {
+	b.bail = true
+	return b
+}
+
+// Check validates the value with a predicate, failing with message when it returns false.
+// The validator is tracked as name.
+func (b *StrSliceBuilder) Check(name string, fn func(v []string) bool, message string) *StrSliceBuilder {
+	b.rules = append(b.rules, rule{validators: []string{name}, eval: func() *Validation { return predicate(b.value, b.field, name, fn, message) }})
+	return b
+}
+
+// Custom applies a validation function to the value and field name.
+func (b *StrSliceBuilder) Custom(fn func(v []string, field string) *Validation) *StrSliceBuilder {
+	b.rules = append(b.rules, rule{eval: func() *Validation { return fn(b.value, b.field) }})
+	return b
+}
+
+// Msg replaces the error message of the most recently added validation.
+// See [WithMessage].
+func (b *StrSliceBuilder) Msg(message string) *StrSliceBuilder {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithMessage(v, message) })
+	return b
+}
+
+// Code replaces the error code of the most recently added validation.
+// See [WithCode].
+func (b *StrSliceBuilder) Code(code string) *StrSliceBuilder {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithCode(v, code) })
 	return b
 }
 
 // NotEmpty validates that the slice is not empty.
 func (b *StrSliceBuilder) NotEmpty() *StrSliceBuilder {
-	b.validations = append(b.validations, NotEmpty(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"required"}, eval: func() *Validation { return NotEmpty(b.value, b.field) }})
 	return b
 }
 
 // MinItems validates minimum slice length.
 func (b *StrSliceBuilder) MinItems(n int) *StrSliceBuilder {
-	b.validations = append(b.validations, MinItems(b.value, n, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"minitems"}, eval: func() *Validation { return MinItems(b.value, n, b.field) }})
 	return b
 }
 
 // MaxItems validates maximum slice length.
 func (b *StrSliceBuilder) MaxItems(n int) *StrSliceBuilder {
-	b.validations = append(b.validations, MaxItems(b.value, n, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"maxitems"}, eval: func() *Validation { return MaxItems(b.value, n, b.field) }})
 	return b
 }
 
 // ItemsBetween validates slice length is within a range.
 func (b *StrSliceBuilder) ItemsBetween(minItems, maxItems int) *StrSliceBuilder {
-	b.validations = append(b.validations, ItemsBetween(b.value, minItems, maxItems, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"minitems", "maxitems"}, eval: func() *Validation { return ItemsBetween(b.value, minItems, maxItems, b.field) }})
 	return b
 }
 
 // Unique validates that all elements are unique.
 func (b *StrSliceBuilder) Unique() *StrSliceBuilder {
-	b.validations = append(b.validations, Unique(b.value, b.field))
+	b.rules = append(b.rules, rule{validators: []string{"unique"}, eval: func() *Validation { return Unique(b.value, b.field) }})
 	return b
 }
 
 // Each applies validations to each element via a StrBuilder.
 // Field names are auto-generated as "field[i]".
 func (b *StrSliceBuilder) Each(fn func(*StrBuilder)) *StrSliceBuilder {
-	for i, item := range b.value {
-		elemField := fmt.Sprintf("%s[%d]", b.field, i)
-		sb := &StrBuilder{value: item, field: elemField}
-		fn(sb)
-		if v := sb.V(); v != nil {
-			b.validations = append(b.validations, v)
+	b.rules = append(b.rules, rule{eval: func() *Validation {
+		var validations []*Validation
+		for i, item := range b.value {
+			elemField := fmt.Sprintf("%s[%d]", b.field, i)
+			sb := &StrBuilder{value: item, field: elemField}
+			fn(sb)
+			if v := sb.V(); v != nil {
+				validations = append(validations, v)
+			}
 		}
-	}
+		return combine(b.field, validations)
+	}})
 	return b
 }
 
@@ -1121,10 +1483,11 @@ func (b *StrSliceBuilder) AllNotBlank() *StrSliceBuilder {
 
 // OptIntBuilder provides fluent validation for optional integer pointers.
 type OptIntBuilder[T Integer] struct {
-	value       *T
-	field       string
-	validations []*Validation
-	skip        bool
+	value *T
+	field string
+	rules []rule
+	bail  bool
+	skip  bool
 }
 
 // OptInt creates a new optional integer validation builder.
@@ -1135,98 +1498,123 @@ func OptInt[T Integer](v *T, field string) *OptIntBuilder[T] {
 // V returns the combined validation result.
 func (b *OptIntBuilder[T]) V() *Validation {
 	if b.skip {
-		return nil
+		return evaluate(b.field, declared(b.field, b.rules), false)
 	}
-	return combine(b.field, b.validations)
+	return evaluate(b.field, b.rules, b.bail)
 }
 
-// When conditionally applies validations.
+// When conditionally applies validations. If cond is false, the steps fn adds
+// are not run but are still recorded as applied.
 func (b *OptIntBuilder[T]) When(cond bool, fn func(*OptIntBuilder[T])) *OptIntBuilder[T] {
-	if cond && !b.skip {
+	if cond {
 		fn(b)
+		return b
 	}
+	skipped := &OptIntBuilder[T]{value: b.value, field: b.field, skip: b.skip}
+	fn(skipped)
+	b.rules = append(b.rules, declared(b.field, skipped.rules)...)
+	return b
+}
+
+// Bail stops reporting errors after the first failed step, so an empty value
+// reports only "is required". Later steps are still recorded as applied.
+func (b *OptIntBuilder[T]) Bail() *OptIntBuilder[T] {
+	b.bail = true
+	return b
+}
+
+// Check validates the value with a predicate, failing with message when it returns false.
+// The validator is tracked as name.
+func (b *OptIntBuilder[T]) Check(name string, fn func(v T) bool, message string) *OptIntBuilder[T] {
+	b.rules = append(b.rules, rule{validators: []string{name}, eval: func() *Validation { return predicate(*b.value, b.field, name, fn, message) }})
+	return b
+}
+
+// Custom applies a validation function to the value and field name.
+func (b *OptIntBuilder[T]) Custom(fn func(v T, field string) *Validation) *OptIntBuilder[T] {
+	b.rules = append(b.rules, rule{eval: func() *Validation { return fn(*b.value, b.field) }})
+	return b
+}
+
+// Msg replaces the error message of the most recently added validation.
+// See [WithMessage].
+func (b *OptIntBuilder[T]) Msg(message string) *OptIntBuilder[T] {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithMessage(v, message) })
+	return b
+}
+
+// Code replaces the error code of the most recently added validation.
+// See [WithCode].
+func (b *OptIntBuilder[T]) Code(code string) *OptIntBuilder[T] {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithCode(v, code) })
 	return b
 }
 
 // Min validates that the value is at least the minimum.
 func (b *OptIntBuilder[T]) Min(minVal T) *OptIntBuilder[T] {
-	if !b.skip {
-		b.validations = append(b.validations, Min(*b.value, minVal, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"min"}, eval: func() *Validation { return Min(*b.value, minVal, b.field) }})
 	return b
 }
 
 // Max validates that the value is at most the maximum.
 func (b *OptIntBuilder[T]) Max(maxVal T) *OptIntBuilder[T] {
-	if !b.skip {
-		b.validations = append(b.validations, Max(*b.value, maxVal, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"max"}, eval: func() *Validation { return Max(*b.value, maxVal, b.field) }})
 	return b
 }
 
 // Between validates that the value is within a range (inclusive).
 func (b *OptIntBuilder[T]) Between(minVal, maxVal T) *OptIntBuilder[T] {
-	if !b.skip {
-		b.validations = append(b.validations, Between(*b.value, minVal, maxVal, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"min", "max"}, eval: func() *Validation { return Between(*b.value, minVal, maxVal, b.field) }})
 	return b
 }
 
 // Positive validates that the value is greater than zero.
 func (b *OptIntBuilder[T]) Positive() *OptIntBuilder[T] {
-	if !b.skip {
-		b.validations = append(b.validations, validation(func() error {
+	b.rules = append(b.rules, rule{validators: []string{"gt"}, eval: func() *Validation {
+		return validation(func() error {
 			if *b.value <= 0 {
-				return fieldErr(b.field, "must be positive")
+				return fieldErr(b.field, "must be positive").withKey("gt.positive")
 			}
 			return nil
-		}(), b.field, "gt"))
-	}
+		}(), b.field, "gt").with("threshold", 0)
+	}})
 	return b
 }
 
 // NonNegative validates that the value is zero or greater.
 func (b *OptIntBuilder[T]) NonNegative() *OptIntBuilder[T] {
-	if !b.skip {
-		b.validations = append(b.validations, validation(func() error {
+	b.rules = append(b.rules, rule{validators: []string{"gte"}, eval: func() *Validation {
+		return validation(func() error {
 			if *b.value < 0 {
-				return fieldErr(b.field, "must not be negative")
+				return fieldErr(b.field, "must not be negative").withKey("gte.nonnegative")
 			}
 			return nil
-		}(), b.field, "gte"))
-	}
+		}(), b.field, "gte").with("threshold", 0)
+	}})
 	return b
 }
 
 // NonZero validates that the value is not zero.
 func (b *OptIntBuilder[T]) NonZero() *OptIntBuilder[T] {
-	if !b.skip {
-		b.validations = append(b.validations, NonZero(*b.value, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"ne"}, eval: func() *Validation { return NonZero(*b.value, b.field) }})
 	return b
 }
 
 // MultipleOf validates that the value is a multiple of the divisor.
 func (b *OptIntBuilder[T]) MultipleOf(divisor T) *OptIntBuilder[T] {
-	if !b.skip {
-		b.validations = append(b.validations, MultipleOf(*b.value, divisor, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"multipleof"}, eval: func() *Validation { return MultipleOf(*b.value, divisor, b.field) }})
 	return b
 }
 
 // Even validates that the value is even.
 func (b *OptIntBuilder[T]) Even() *OptIntBuilder[T] {
-	if !b.skip {
-		b.validations = append(b.validations, Even(*b.value, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"even"}, eval: func() *Validation { return Even(*b.value, b.field) }})
 	return b
 }
 
 // Odd validates that the value is odd.
 func (b *OptIntBuilder[T]) Odd() *OptIntBuilder[T] {
-	if !b.skip {
-		b.validations = append(b.validations, Odd(*b.value, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"odd"}, eval: func() *Validation { return Odd(*b.value, b.field) }})
 	return b
 }
 
@@ -1236,10 +1624,11 @@ func (b *OptIntBuilder[T]) Odd() *OptIntBuilder[T] {
 
 // OptSliceBuilder provides fluent validation for optional slice pointers.
 type OptSliceBuilder[T any] struct {
-	value       *[]T
-	field       string
-	validations []*Validation
-	skip        bool
+	value *[]T
+	field string
+	rules []rule
+	bail  bool
+	skip  bool
 }
 
 // OptSlice creates a new optional slice validation builder.
@@ -1250,61 +1639,94 @@ func OptSlice[T any](v *[]T, field string) *OptSliceBuilder[T] {
 // V returns the combined validation result.
 func (b *OptSliceBuilder[T]) V() *Validation {
 	if b.skip {
-		return nil
+		return evaluate(b.field, declared(b.field, b.rules), false)
 	}
-	return combine(b.field, b.validations)
+	return evaluate(b.field, b.rules, b.bail)
 }
 
-// When conditionally applies validations.
+// When conditionally applies validations. If cond is false, the steps fn adds
+// are not run but are still recorded as applied.
 func (b *OptSliceBuilder[T]) When(cond bool, fn func(*OptSliceBuilder[T])) *OptSliceBuilder[T] {
-	if cond && !b.skip {
+	if cond {
 		fn(b)
+		return b
 	}
+	skipped := &OptSliceBuilder[T]{value: b.value, field: b.field, skip: b.skip}
+	fn(skipped)
+	b.rules = append(b.rules, declared(b.field, skipped.rules)...)
+	return b
+}
+
+// Bail stops reporting errors after the first failed step, so an empty value
+// reports only "is required". Later steps are still recorded as applied.
+func (b *OptSliceBuilder[T]) Bail() *OptSliceBuilder[T] {
+	b.bail = true
+	return b
+}
+
+// Check validates the value with a predicate, failing with message when it returns false.
+// The validator is tracked as name.
+func (b *OptSliceBuilder[T]) Check(name string, fn func(v []T) bool, message string) *OptSliceBuilder[T] {
+	b.rules = append(b.rules, rule{validators: []string{name}, eval: func() *Validation { return predicate(*b.value, b.field, name, fn, message) }})
+	return b
+}
+
+// Custom applies a validation function to the value and field name.
+func (b *OptSliceBuilder[T]) Custom(fn func(v []T, field string) *Validation) *OptSliceBuilder[T] {
+	b.rules = append(b.rules, rule{eval: func() *Validation { return fn(*b.value, b.field) }})
+	return b
+}
+
+// Msg replaces the error message of the most recently added validation.
+// See [WithMessage].
+func (b *OptSliceBuilder[T]) Msg(message string) *OptSliceBuilder[T] {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithMessage(v, message) })
+	return b
+}
+
+// Code replaces the error code of the most recently added validation.
+// See [WithCode].
+func (b *OptSliceBuilder[T]) Code(code string) *OptSliceBuilder[T] {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithCode(v, code) })
 	return b
 }
 
 // NotEmpty validates that the slice is not empty.
 func (b *OptSliceBuilder[T]) NotEmpty() *OptSliceBuilder[T] {
-	if !b.skip {
-		b.validations = append(b.validations, NotEmpty(*b.value, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"required"}, eval: func() *Validation { return NotEmpty(*b.value, b.field) }})
 	return b
 }
 
 // MinItems validates minimum slice length.
 func (b *OptSliceBuilder[T]) MinItems(n int) *OptSliceBuilder[T] {
-	if !b.skip {
-		b.validations = append(b.validations, MinItems(*b.value, n, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"minitems"}, eval: func() *Validation { return MinItems(*b.value, n, b.field) }})
 	return b
 }
 
 // MaxItems validates maximum slice length.
 func (b *OptSliceBuilder[T]) MaxItems(n int) *OptSliceBuilder[T] {
-	if !b.skip {
-		b.validations = append(b.validations, MaxItems(*b.value, n, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"maxitems"}, eval: func() *Validation { return MaxItems(*b.value, n, b.field) }})
 	return b
 }
 
 // ItemsBetween validates slice length is within a range.
 func (b *OptSliceBuilder[T]) ItemsBetween(minItems, maxItems int) *OptSliceBuilder[T] {
-	if !b.skip {
-		b.validations = append(b.validations, ItemsBetween(*b.value, minItems, maxItems, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"minitems", "maxitems"}, eval: func() *Validation { return ItemsBetween(*b.value, minItems, maxItems, b.field) }})
 	return b
 }
 
 // EachV applies a validation to each element, collecting results.
 func (b *OptSliceBuilder[T]) EachV(fn func(v T, field string) *Validation) *OptSliceBuilder[T] {
-	if !b.skip {
+	b.rules = append(b.rules, rule{eval: func() *Validation {
+		var validations []*Validation
 		for i, item := range *b.value {
 			elemField := fmt.Sprintf("%s[%d]", b.field, i)
 			if v := fn(item, elemField); v != nil {
-				b.validations = append(b.validations, v)
+				validations = append(validations, v)
 			}
 		}
-	}
+		return combine(b.field, validations)
+	}})
 	return b
 }
 
@@ -1314,10 +1736,11 @@ func (b *OptSliceBuilder[T]) EachV(fn func(v T, field string) *Validation) *OptS
 
 // OptStrSliceBuilder provides fluent validation for optional string slice pointers.
 type OptStrSliceBuilder struct {
-	value       *[]string
-	field       string
-	validations []*Validation
-	skip        bool
+	value *[]string
+	field string
+	rules []rule
+	bail  bool
+	skip  bool
 }
 
 // OptStrSlice creates a new optional string slice validation builder.
@@ -1328,63 +1751,96 @@ func OptStrSlice(v *[]string, field string) *OptStrSliceBuilder {
 // V returns the combined validation result.
 func (b *OptStrSliceBuilder) V() *Validation {
 	if b.skip {
-		return nil
+		return evaluate(b.field, declared(b.field, b.rules), false)
 	}
-	return combine(b.field, b.validations)
+	return evaluate(b.field, b.rules, b.bail)
 }
 
-// When conditionally applies validations.
+// When conditionally applies validations. If cond is false, the steps fn adds
+// are not run but are still recorded as applied.
 func (b *OptStrSliceBuilder) When(cond bool, fn func(*OptStrSliceBuilder)) *OptStrSliceBuilder {
-	if cond && !b.skip {
+	if cond {
 		fn(b)
+		return b
 	}
+	skipped := &OptStrSliceBuilder{value: b.value, field: b.field, skip: b.skip}
+	fn(skipped)
+	b.rules = append(b.rules, declared(b.field, skipped.rules)...)
 	return b
 }
 
-// NotEmpty validates that the slice is not empty.
-func (b *OptStrSliceBuilder) NotEmpty() *OptStrSliceBuilder {
-	if !b.skip {
-		b.validations = append(b.validations, NotEmpty(*b.value, b.field))
-	}
+// Bail stops reporting errors after the first failed step, so an empty value
+// reports only "is required". Later steps are still recorded as applied.
+func (b *OptStrSliceBuilder) Bail() *OptStrSliceBuilder {
+	b.bail = true
 	return b
 }
 
-// MinItems validates minimum slice length.
-func (b *OptStrSliceBuilder) MinItems(n int) *OptStrSliceBuilder {
-	if !b.skip {
-		b.validations = append(b.validations, MinItems(*b.value, n, b.field))
-	}
+// Check validates the value with a predicate, failing with message when it returns false.
+// The validator is tracked as name.
+func (b *OptStrSliceBuilder) Check(name string, fn func(v []string) bool, message string) *OptStrSliceBuilder {
+	b.rules = append(b.rules, rule{validators: []string{name}, eval: func() *Validation { return predicate(*b.value, b.field, name, fn, message) }})
 	return b
 }
 
-// MaxItems validates maximum slice length.
-func (b *OptStrSliceBuilder) MaxItems(n int) *OptStrSliceBuilder {
-	if !b.skip {
-		b.validations = append(b.validations, MaxItems(*b.value, n, b.field))
-	}
+// Custom applies a validation function to the value and field name.
+func (b *OptStrSliceBuilder) Custom(fn func(v []string, field string) *Validation) *OptStrSliceBuilder {
+	b.rules = append(b.rules, rule{eval: func() *Validation { return fn(*b.value, b.field) }})
+	return b
+}
+
+// Msg replaces the error message of the most recently added validation.
+// See [WithMessage].
+func (b *OptStrSliceBuilder) Msg(message string) *OptStrSliceBuilder {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithMessage(v, message) })
+	return b
+}
+
+// Code replaces the error code of the most recently added validation.
+// See [WithCode].
+func (b *OptStrSliceBuilder) Code(code string) *OptStrSliceBuilder {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithCode(v, code) })
+	return b
+}
+
+// NotEmpty validates that the slice is not empty.
+func (b *OptStrSliceBuilder) NotEmpty() *OptStrSliceBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"required"}, eval: func() *Validation { return NotEmpty(*b.value, b.field) }})
+	return b
+}
+
+// MinItems validates minimum slice length.
+func (b *OptStrSliceBuilder) MinItems(n int) *OptStrSliceBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"minitems"}, eval: func() *Validation { return MinItems(*b.value, n, b.field) }})
+	return b
+}
+
+// MaxItems validates maximum slice length.
+func (b *OptStrSliceBuilder) MaxItems(n int) *OptStrSliceBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"maxitems"}, eval: func() *Validation { return MaxItems(*b.value, n, b.field) }})
 	return b
 }
 
 // Unique validates that all elements are unique.
 func (b *OptStrSliceBuilder) Unique() *OptStrSliceBuilder {
-	if !b.skip {
-		b.validations = append(b.validations, Unique(*b.value, b.field))
-	}
+	b.rules = append(b.rules, rule{validators: []string{"unique"}, eval: func() *Validation { return Unique(*b.value, b.field) }})
 	return b
 }
 
 // Each applies validations to each element via a StrBuilder.
 func (b *OptStrSliceBuilder) Each(fn func(*StrBuilder)) *OptStrSliceBuilder {
-	if !b.skip {
+	b.rules = append(b.rules, rule{eval: func() *Validation {
+		var validations []*Validation
 		for i, item := range *b.value {
 			elemField := fmt.Sprintf("%s[%d]", b.field, i)
 			sb := &StrBuilder{value: item, field: elemField}
 			fn(sb)
 			if v := sb.V(); v != nil {
-				b.validations = append(b.validations, v)
+				validations = append(validations, v)
 			}
 		}
-	}
+		return combine(b.field, validations)
+	}})
 	return b
 }
 
@@ -1397,3 +1853,1050 @@ func (b *OptStrSliceBuilder) AllMaxLen(n int) *OptStrSliceBuilder {
 func (b *OptStrSliceBuilder) AllNotBlank() *OptStrSliceBuilder {
 	return b.Each(func(sb *StrBuilder) { sb.NotBlank() })
 }
+
+// -----------------------------------------------------------------------------
+// Time Builder
+// -----------------------------------------------------------------------------
+
+// TimeBuilder provides fluent validation for time values.
+type TimeBuilder struct {
+	value time.Time
+	field string
+	clock Clock
+	rules []rule
+	bail  bool
+}
+
+// Time creates a new time validation builder.
+func Time(v time.Time, field string) *TimeBuilder {
+	return &TimeBuilder{value: v, field: field}
+}
+
+// V returns the combined validation result.
+func (b *TimeBuilder) V() *Validation {
+	return evaluate(b.field, b.rules, b.bail)
+}
+
+// When conditionally applies validations. If cond is false, the steps fn adds
+// are not run but are still recorded as applied.
+func (b *TimeBuilder) When(cond bool, fn func(*TimeBuilder)) *TimeBuilder {
+	if cond {
+		fn(b)
+		return b
+	}
+	skipped := &TimeBuilder{value: b.value, field: b.field, clock: b.clock}
+	fn(skipped)
+	b.rules = append(b.rules, declared(b.field, skipped.rules)...)
+	return b
+}
+
+// Bail stops reporting errors after the first failed step, so an empty value
+// reports only "is required". Later steps are still recorded as applied.
+func (b *TimeBuilder) Bail() *TimeBuilder {
+	b.bail = true
+	return b
+}
+
+// Check validates the value with a predicate, failing with message when it returns false.
+// The validator is tracked as name.
+func (b *TimeBuilder) Check(name string, fn func(v time.Time) bool, message string) *TimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{name}, eval: func() *Validation { return predicate(b.value, b.field, name, fn, message) }})
+	return b
+}
+
+// Custom applies a validation function to the value and field name.
+func (b *TimeBuilder) Custom(fn func(v time.Time, field string) *Validation) *TimeBuilder {
+	b.rules = append(b.rules, rule{eval: func() *Validation { return fn(b.value, b.field) }})
+	return b
+}
+
+// Msg replaces the error message of the most recently added validation.
+// See [WithMessage].
+func (b *TimeBuilder) Msg(message string) *TimeBuilder {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithMessage(v, message) })
+	return b
+}
+
+// Code replaces the error code of the most recently added validation.
+// See [WithCode].
+func (b *TimeBuilder) Code(code string) *TimeBuilder {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithCode(v, code) })
+	return b
+}
+
+// Clock sets the clock used by the now-relative validators that follow it,
+// such as BeforeNow and WithinDuration. Defaults to the package clock; see [SetClock].
+func (b *TimeBuilder) Clock(c Clock) *TimeBuilder {
+	b.clock = c
+	return b
+}
+
+// now returns a function reading the current time from the clock set when
+// it is called, so steps keep their clock when Clock is called again later.
+func (b *TimeBuilder) now() func() time.Time {
+	if b.clock == nil {
+		return currentTime
+	}
+	return b.clock.Now
+}
+
+// Required validates that the time is not the zero value.
+func (b *TimeBuilder) Required() *TimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"required"}, eval: func() *Validation { return NotZeroTime(b.value, b.field) }})
+	return b
+}
+
+// Zero validates that the time is the zero value.
+func (b *TimeBuilder) Zero() *TimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"empty"}, eval: func() *Validation { return ZeroTime(b.value, b.field) }})
+	return b
+}
+
+// Before validates that the time is before t.
+func (b *TimeBuilder) Before(t time.Time) *TimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"before"}, eval: func() *Validation { return Before(b.value, t, b.field) }})
+	return b
+}
+
+// After validates that the time is after t.
+func (b *TimeBuilder) After(t time.Time) *TimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"after"}, eval: func() *Validation { return After(b.value, t, b.field) }})
+	return b
+}
+
+// BeforeOrEqual validates that the time is before or equal to t.
+func (b *TimeBuilder) BeforeOrEqual(t time.Time) *TimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"lte"}, eval: func() *Validation { return BeforeOrEqual(b.value, t, b.field) }})
+	return b
+}
+
+// AfterOrEqual validates that the time is after or equal to t.
+func (b *TimeBuilder) AfterOrEqual(t time.Time) *TimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"gte"}, eval: func() *Validation { return AfterOrEqual(b.value, t, b.field) }})
+	return b
+}
+
+// BeforeNow validates that the time is before the builder's clock.
+func (b *TimeBuilder) BeforeNow() *TimeBuilder {
+	now := b.now()
+	b.rules = append(b.rules, rule{validators: []string{"past"}, eval: func() *Validation { return BeforeNowAt(b.value, now(), b.field) }})
+	return b
+}
+
+// AfterNow validates that the time is after the builder's clock.
+func (b *TimeBuilder) AfterNow() *TimeBuilder {
+	now := b.now()
+	b.rules = append(b.rules, rule{validators: []string{"future"}, eval: func() *Validation { return AfterNowAt(b.value, now(), b.field) }})
+	return b
+}
+
+// BeforeOrEqualNow validates that the time is not after the builder's clock.
+func (b *TimeBuilder) BeforeOrEqualNow() *TimeBuilder {
+	now := b.now()
+	b.rules = append(b.rules, rule{validators: []string{"pastoreq"}, eval: func() *Validation { return BeforeOrEqualNowAt(b.value, now(), b.field) }})
+	return b
+}
+
+// AfterOrEqualNow validates that the time is not before the builder's clock.
+func (b *TimeBuilder) AfterOrEqualNow() *TimeBuilder {
+	now := b.now()
+	b.rules = append(b.rules, rule{validators: []string{"futureoreq"}, eval: func() *Validation { return AfterOrEqualNowAt(b.value, now(), b.field) }})
+	return b
+}
+
+// InPast is an alias for BeforeNow.
+func (b *TimeBuilder) InPast() *TimeBuilder {
+	return b.BeforeNow()
+}
+
+// InFuture is an alias for AfterNow.
+func (b *TimeBuilder) InFuture() *TimeBuilder {
+	return b.AfterNow()
+}
+
+// Between validates that the time is within a range (inclusive).
+func (b *TimeBuilder) Between(start, end time.Time) *TimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"after", "before"}, eval: func() *Validation { return BetweenTime(b.value, start, end, b.field) }})
+	return b
+}
+
+// BetweenExclusive validates that the time is within a range (exclusive).
+func (b *TimeBuilder) BetweenExclusive(start, end time.Time) *TimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"gt", "lt"}, eval: func() *Validation { return BetweenTimeExclusive(b.value, start, end, b.field) }})
+	return b
+}
+
+// WithinDuration validates that the time is within d of the builder's clock.
+func (b *TimeBuilder) WithinDuration(d time.Duration) *TimeBuilder {
+	now := b.now()
+	b.rules = append(b.rules, rule{validators: []string{"within"}, eval: func() *Validation { return WithinDurationAt(b.value, d, now(), b.field) }})
+	return b
+}
+
+// WithinDurationOf validates that the time is within d of a reference time.
+func (b *TimeBuilder) WithinDurationOf(d time.Duration, ref time.Time) *TimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"within"}, eval: func() *Validation { return WithinDurationOf(b.value, d, ref, b.field) }})
+	return b
+}
+
+// SameDay validates that the time is on the same day as ref.
+func (b *TimeBuilder) SameDay(ref time.Time) *TimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"sameday"}, eval: func() *Validation { return SameDay(b.value, ref, b.field) }})
+	return b
+}
+
+// SameMonth validates that the time is in the same month as ref.
+func (b *TimeBuilder) SameMonth(ref time.Time) *TimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"samemonth"}, eval: func() *Validation { return SameMonth(b.value, ref, b.field) }})
+	return b
+}
+
+// SameYear validates that the time is in the same year as ref.
+func (b *TimeBuilder) SameYear(ref time.Time) *TimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"sameyear"}, eval: func() *Validation { return SameYear(b.value, ref, b.field) }})
+	return b
+}
+
+// Weekday validates that the time is on the given weekday.
+func (b *TimeBuilder) Weekday(day time.Weekday) *TimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"weekday"}, eval: func() *Validation { return Weekday(b.value, day, b.field) }})
+	return b
+}
+
+// WeekdayIn validates that the time is on one of the given weekdays.
+func (b *TimeBuilder) WeekdayIn(days []time.Weekday) *TimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"weekday"}, eval: func() *Validation { return WeekdayIn(b.value, days, b.field) }})
+	return b
+}
+
+// NotWeekend validates that the time is not on Saturday or Sunday.
+func (b *TimeBuilder) NotWeekend() *TimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"notweekend"}, eval: func() *Validation { return NotWeekend(b.value, b.field) }})
+	return b
+}
+
+// IsWeekend validates that the time is on Saturday or Sunday.
+func (b *TimeBuilder) IsWeekend() *TimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"weekend"}, eval: func() *Validation { return IsWeekend(b.value, b.field) }})
+	return b
+}
+
+// InTimezone validates that the time's location matches loc.
+func (b *TimeBuilder) InTimezone(loc *time.Location) *TimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"timezone"}, eval: func() *Validation { return TimeInTimezone(b.value, loc, b.field) }})
+	return b
+}
+
+// -----------------------------------------------------------------------------
+// Optional Time Builder
+// -----------------------------------------------------------------------------
+
+// OptTimeBuilder provides fluent validation for optional time pointers.
+type OptTimeBuilder struct {
+	value *time.Time
+	field string
+	clock Clock
+	rules []rule
+	bail  bool
+	skip  bool
+}
+
+// OptTime creates a new optional time validation builder.
+// If the pointer is nil, validations are skipped but still recorded as applied.
+func OptTime(v *time.Time, field string) *OptTimeBuilder {
+	return &OptTimeBuilder{value: v, field: field, skip: v == nil}
+}
+
+// V returns the combined validation result.
+// If the value is nil, it passes and records the declared validators.
+func (b *OptTimeBuilder) V() *Validation {
+	if b.skip {
+		return evaluate(b.field, declared(b.field, b.rules), false)
+	}
+	return evaluate(b.field, b.rules, b.bail)
+}
+
+// When conditionally applies validations. If cond is false, the steps fn adds
+// are not run but are still recorded as applied.
+func (b *OptTimeBuilder) When(cond bool, fn func(*OptTimeBuilder)) *OptTimeBuilder {
+	if cond {
+		fn(b)
+		return b
+	}
+	skipped := &OptTimeBuilder{value: b.value, field: b.field, clock: b.clock, skip: b.skip}
+	fn(skipped)
+	b.rules = append(b.rules, declared(b.field, skipped.rules)...)
+	return b
+}
+
+// Bail stops reporting errors after the first failed step, so an empty value
+// reports only "is required". Later steps are still recorded as applied.
+func (b *OptTimeBuilder) Bail() *OptTimeBuilder {
+	b.bail = true
+	return b
+}
+
+// Check validates the value with a predicate, failing with message when it returns false.
+// The validator is tracked as name.
+func (b *OptTimeBuilder) Check(name string, fn func(v time.Time) bool, message string) *OptTimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{name}, eval: func() *Validation { return predicate(*b.value, b.field, name, fn, message) }})
+	return b
+}
+
+// Custom applies a validation function to the value and field name.
+func (b *OptTimeBuilder) Custom(fn func(v time.Time, field string) *Validation) *OptTimeBuilder {
+	b.rules = append(b.rules, rule{eval: func() *Validation { return fn(*b.value, b.field) }})
+	return b
+}
+
+// Msg replaces the error message of the most recently added validation.
+// See [WithMessage].
+func (b *OptTimeBuilder) Msg(message string) *OptTimeBuilder {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithMessage(v, message) })
+	return b
+}
+
+// Code replaces the error code of the most recently added validation.
+// See [WithCode].
+func (b *OptTimeBuilder) Code(code string) *OptTimeBuilder {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithCode(v, code) })
+	return b
+}
+
+// Clock sets the clock used by the now-relative validators that follow it,
+// such as BeforeNow and WithinDuration. Defaults to the package clock; see [SetClock].
+func (b *OptTimeBuilder) Clock(c Clock) *OptTimeBuilder {
+	b.clock = c
+	return b
+}
+
+// now returns a function reading the current time from the clock set when
+// it is called, so steps keep their clock when Clock is called again later.
+func (b *OptTimeBuilder) now() func() time.Time {
+	if b.clock == nil {
+		return currentTime
+	}
+	return b.clock.Now
+}
+
+// Required validates that the time is not the zero value.
+func (b *OptTimeBuilder) Required() *OptTimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"required"}, eval: func() *Validation { return NotZeroTime(*b.value, b.field) }})
+	return b
+}
+
+// Zero validates that the time is the zero value.
+func (b *OptTimeBuilder) Zero() *OptTimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"empty"}, eval: func() *Validation { return ZeroTime(*b.value, b.field) }})
+	return b
+}
+
+// Before validates that the time is before t.
+func (b *OptTimeBuilder) Before(t time.Time) *OptTimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"before"}, eval: func() *Validation { return Before(*b.value, t, b.field) }})
+	return b
+}
+
+// After validates that the time is after t.
+func (b *OptTimeBuilder) After(t time.Time) *OptTimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"after"}, eval: func() *Validation { return After(*b.value, t, b.field) }})
+	return b
+}
+
+// BeforeOrEqual validates that the time is before or equal to t.
+func (b *OptTimeBuilder) BeforeOrEqual(t time.Time) *OptTimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"lte"}, eval: func() *Validation { return BeforeOrEqual(*b.value, t, b.field) }})
+	return b
+}
+
+// AfterOrEqual validates that the time is after or equal to t.
+func (b *OptTimeBuilder) AfterOrEqual(t time.Time) *OptTimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"gte"}, eval: func() *Validation { return AfterOrEqual(*b.value, t, b.field) }})
+	return b
+}
+
+// BeforeNow validates that the time is before the builder's clock.
+func (b *OptTimeBuilder) BeforeNow() *OptTimeBuilder {
+	now := b.now()
+	b.rules = append(b.rules, rule{validators: []string{"past"}, eval: func() *Validation { return BeforeNowAt(*b.value, now(), b.field) }})
+	return b
+}
+
+// AfterNow validates that the time is after the builder's clock.
+func (b *OptTimeBuilder) AfterNow() *OptTimeBuilder {
+	now := b.now()
+	b.rules = append(b.rules, rule{validators: []string{"future"}, eval: func() *Validation { return AfterNowAt(*b.value, now(), b.field) }})
+	return b
+}
+
+// BeforeOrEqualNow validates that the time is not after the builder's clock.
+func (b *OptTimeBuilder) BeforeOrEqualNow() *OptTimeBuilder {
+	now := b.now()
+	b.rules = append(b.rules, rule{validators: []string{"pastoreq"}, eval: func() *Validation { return BeforeOrEqualNowAt(*b.value, now(), b.field) }})
+	return b
+}
+
+// AfterOrEqualNow validates that the time is not before the builder's clock.
+func (b *OptTimeBuilder) AfterOrEqualNow() *OptTimeBuilder {
+	now := b.now()
+	b.rules = append(b.rules, rule{validators: []string{"futureoreq"}, eval: func() *Validation { return AfterOrEqualNowAt(*b.value, now(), b.field) }})
+	return b
+}
+
+// InPast is an alias for BeforeNow.
+func (b *OptTimeBuilder) InPast() *OptTimeBuilder {
+	return b.BeforeNow()
+}
+
+// InFuture is an alias for AfterNow.
+func (b *OptTimeBuilder) InFuture() *OptTimeBuilder {
+	return b.AfterNow()
+}
+
+// Between validates that the time is within a range (inclusive).
+func (b *OptTimeBuilder) Between(start, end time.Time) *OptTimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"after", "before"}, eval: func() *Validation { return BetweenTime(*b.value, start, end, b.field) }})
+	return b
+}
+
+// BetweenExclusive validates that the time is within a range (exclusive).
+func (b *OptTimeBuilder) BetweenExclusive(start, end time.Time) *OptTimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"gt", "lt"}, eval: func() *Validation { return BetweenTimeExclusive(*b.value, start, end, b.field) }})
+	return b
+}
+
+// WithinDuration validates that the time is within d of the builder's clock.
+func (b *OptTimeBuilder) WithinDuration(d time.Duration) *OptTimeBuilder {
+	now := b.now()
+	b.rules = append(b.rules, rule{validators: []string{"within"}, eval: func() *Validation { return WithinDurationAt(*b.value, d, now(), b.field) }})
+	return b
+}
+
+// WithinDurationOf validates that the time is within d of a reference time.
+func (b *OptTimeBuilder) WithinDurationOf(d time.Duration, ref time.Time) *OptTimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"within"}, eval: func() *Validation { return WithinDurationOf(*b.value, d, ref, b.field) }})
+	return b
+}
+
+// SameDay validates that the time is on the same day as ref.
+func (b *OptTimeBuilder) SameDay(ref time.Time) *OptTimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"sameday"}, eval: func() *Validation { return SameDay(*b.value, ref, b.field) }})
+	return b
+}
+
+// SameMonth validates that the time is in the same month as ref.
+func (b *OptTimeBuilder) SameMonth(ref time.Time) *OptTimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"samemonth"}, eval: func() *Validation { return SameMonth(*b.value, ref, b.field) }})
+	return b
+}
+
+// SameYear validates that the time is in the same year as ref.
+func (b *OptTimeBuilder) SameYear(ref time.Time) *OptTimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"sameyear"}, eval: func() *Validation { return SameYear(*b.value, ref, b.field) }})
+	return b
+}
+
+// Weekday validates that the time is on the given weekday.
+func (b *OptTimeBuilder) Weekday(day time.Weekday) *OptTimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"weekday"}, eval: func() *Validation { return Weekday(*b.value, day, b.field) }})
+	return b
+}
+
+// WeekdayIn validates that the time is on one of the given weekdays.
+func (b *OptTimeBuilder) WeekdayIn(days []time.Weekday) *OptTimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"weekday"}, eval: func() *Validation { return WeekdayIn(*b.value, days, b.field) }})
+	return b
+}
+
+// NotWeekend validates that the time is not on Saturday or Sunday.
+func (b *OptTimeBuilder) NotWeekend() *OptTimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"notweekend"}, eval: func() *Validation { return NotWeekend(*b.value, b.field) }})
+	return b
+}
+
+// IsWeekend validates that the time is on Saturday or Sunday.
+func (b *OptTimeBuilder) IsWeekend() *OptTimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"weekend"}, eval: func() *Validation { return IsWeekend(*b.value, b.field) }})
+	return b
+}
+
+// InTimezone validates that the time's location matches loc.
+func (b *OptTimeBuilder) InTimezone(loc *time.Location) *OptTimeBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"timezone"}, eval: func() *Validation { return TimeInTimezone(*b.value, loc, b.field) }})
+	return b
+}
+
+// -----------------------------------------------------------------------------
+// Map Builders
+// -----------------------------------------------------------------------------
+
+// MapBuilder provides fluent validation for map values.
+// Per-entry validations visit keys in sorted order and use paths like "labels[env]".
+type MapBuilder[K comparable, V any] struct {
+	value map[K]V
+	field string
+	rules []rule
+	bail  bool
+}
+
+// Map creates a new map validation builder.
+func Map[K comparable, V any](v map[K]V, field string) *MapBuilder[K, V] {
+	return &MapBuilder[K, V]{value: v, field: field}
+}
+
+// V returns the combined validation result.
+func (b *MapBuilder[K, V]) V() *Validation {
+	return evaluate(b.field, b.rules, b.bail)
+}
+
+// When conditionally applies validations. If cond is false, the steps fn adds
+// are not run but are still recorded as applied.
+func (b *MapBuilder[K, V]) When(cond bool, fn func(*MapBuilder[K, V])) *MapBuilder[K, V] {
+	if cond {
+		fn(b)
+		return b
+	}
+	skipped := &MapBuilder[K, V]{value: b.value, field: b.field}
+	fn(skipped)
+	b.rules = append(b.rules, declared(b.field, skipped.rules)...)
+	return b
+}
+
+// Bail stops reporting errors after the first failed step, so an empty value
+// reports only "is required". Later steps are still recorded as applied.
+func (b *MapBuilder[K, V]) Bail() *MapBuilder[K, V] {
+	b.bail = true
+	return b
+}
+
+// Check validates the value with a predicate, failing with message when it returns false.
+// The validator is tracked as name.
+func (b *MapBuilder[K, V]) Check(name string, fn func(v map[K]V) bool, message string) *MapBuilder[K, V] {
+	b.rules = append(b.rules, rule{validators: []string{name}, eval: func() *Validation { return predicate(b.value, b.field, name, fn, message) }})
+	return b
+}
+
+// Custom applies a validation function to the value and field name.
+func (b *MapBuilder[K, V]) Custom(fn func(v map[K]V, field string) *Validation) *MapBuilder[K, V] {
+	b.rules = append(b.rules, rule{eval: func() *Validation { return fn(b.value, b.field) }})
+	return b
+}
+
+// Msg replaces the error message of the most recently added validation.
+// See [WithMessage].
+func (b *MapBuilder[K, V]) Msg(message string) *MapBuilder[K, V] {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithMessage(v, message) })
+	return b
+}
+
+// Code replaces the error code of the most recently added validation.
+// See [WithCode].
+func (b *MapBuilder[K, V]) Code(code string) *MapBuilder[K, V] {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithCode(v, code) })
+	return b
+}
+
+// NotEmpty validates that the map is not empty.
+func (b *MapBuilder[K, V]) NotEmpty() *MapBuilder[K, V] {
+	b.rules = append(b.rules, rule{validators: []string{"required"}, eval: func() *Validation { return NotEmptyMap(b.value, b.field) }})
+	return b
+}
+
+// Empty validates that the map is empty.
+func (b *MapBuilder[K, V]) Empty() *MapBuilder[K, V] {
+	b.rules = append(b.rules, rule{validators: []string{"empty"}, eval: func() *Validation { return EmptyMap(b.value, b.field) }})
+	return b
+}
+
+// MinKeys validates the minimum number of keys.
+func (b *MapBuilder[K, V]) MinKeys(n int) *MapBuilder[K, V] {
+	b.rules = append(b.rules, rule{validators: []string{"minkeys"}, eval: func() *Validation { return MinKeys(b.value, n, b.field) }})
+	return b
+}
+
+// MaxKeys validates the maximum number of keys.
+func (b *MapBuilder[K, V]) MaxKeys(n int) *MapBuilder[K, V] {
+	b.rules = append(b.rules, rule{validators: []string{"maxkeys"}, eval: func() *Validation { return MaxKeys(b.value, n, b.field) }})
+	return b
+}
+
+// ExactKeys validates the exact number of keys.
+func (b *MapBuilder[K, V]) ExactKeys(n int) *MapBuilder[K, V] {
+	b.rules = append(b.rules, rule{validators: []string{"len"}, eval: func() *Validation { return ExactKeys(b.value, n, b.field) }})
+	return b
+}
+
+// KeysBetween validates the number of keys is within a range.
+func (b *MapBuilder[K, V]) KeysBetween(minKeys, maxKeys int) *MapBuilder[K, V] {
+	b.rules = append(b.rules, rule{validators: []string{"minkeys", "maxkeys"}, eval: func() *Validation { return KeysBetween(b.value, minKeys, maxKeys, b.field) }})
+	return b
+}
+
+// HasKey validates that the map contains the key.
+func (b *MapBuilder[K, V]) HasKey(key K) *MapBuilder[K, V] {
+	b.rules = append(b.rules, rule{validators: []string{"haskey"}, eval: func() *Validation { return HasKey(b.value, key, b.field) }})
+	return b
+}
+
+// HasKeys validates that the map contains all the keys.
+func (b *MapBuilder[K, V]) HasKeys(keys []K) *MapBuilder[K, V] {
+	b.rules = append(b.rules, rule{validators: []string{"haskeys"}, eval: func() *Validation { return HasKeys(b.value, keys, b.field) }})
+	return b
+}
+
+// HasAnyKey validates that the map contains at least one of the keys.
+func (b *MapBuilder[K, V]) HasAnyKey(keys []K) *MapBuilder[K, V] {
+	b.rules = append(b.rules, rule{validators: []string{"hasanykey"}, eval: func() *Validation { return HasAnyKey(b.value, keys, b.field) }})
+	return b
+}
+
+// NotHasKey validates that the map does not contain the key.
+func (b *MapBuilder[K, V]) NotHasKey(key K) *MapBuilder[K, V] {
+	b.rules = append(b.rules, rule{validators: []string{"nothaskey"}, eval: func() *Validation { return NotHasKey(b.value, key, b.field) }})
+	return b
+}
+
+// NotHasKeys validates that the map contains none of the keys.
+func (b *MapBuilder[K, V]) NotHasKeys(keys []K) *MapBuilder[K, V] {
+	b.rules = append(b.rules, rule{validators: []string{"nothaskeys"}, eval: func() *Validation { return NotHasKeys(b.value, keys, b.field) }})
+	return b
+}
+
+// OnlyKeys validates that the map only contains keys from the allowed set.
+func (b *MapBuilder[K, V]) OnlyKeys(allowed []K) *MapBuilder[K, V] {
+	b.rules = append(b.rules, rule{validators: []string{"onlykeys"}, eval: func() *Validation { return OnlyKeys(b.value, allowed, b.field) }})
+	return b
+}
+
+// UniqueValues validates that all values in the map are unique.
+// Values that cannot be compared, such as slices, fail with an error.
+func (b *MapBuilder[K, V]) UniqueValues() *MapBuilder[K, V] {
+	b.rules = append(b.rules, rule{validators: []string{"unique"}, eval: func() *Validation { return uniqueMapValues(b.value, b.field) }})
+	return b
+}
+
+// EachKey applies a validation to each key, collecting results.
+// Keys are visited in sorted order; the function receives the field name "field[key]".
+func (b *MapBuilder[K, V]) EachKey(fn func(k K, field string) *Validation) *MapBuilder[K, V] {
+	b.rules = append(b.rules, rule{eval: func() *Validation {
+		var validations []*Validation
+		for _, key := range sortedKeys(b.value) {
+			if v := fn(key, mapField(b.field, key)); v != nil {
+				validations = append(validations, v)
+			}
+		}
+		return combine(b.field, validations)
+	}})
+	return b
+}
+
+// EachValue applies a validation to each value, collecting results.
+// Keys are visited in sorted order; the function receives the field name "field[key]".
+func (b *MapBuilder[K, V]) EachValue(fn func(v V, field string) *Validation) *MapBuilder[K, V] {
+	b.rules = append(b.rules, rule{eval: func() *Validation {
+		var validations []*Validation
+		for _, key := range sortedKeys(b.value) {
+			if v := fn(b.value[key], mapField(b.field, key)); v != nil {
+				validations = append(validations, v)
+			}
+		}
+		return combine(b.field, validations)
+	}})
+	return b
+}
+
+// EachEntry applies a validation to each key-value pair, collecting results.
+// Keys are visited in sorted order; the function receives the field name "field[key]".
+func (b *MapBuilder[K, V]) EachEntry(fn func(k K, v V, field string) *Validation) *MapBuilder[K, V] {
+	b.rules = append(b.rules, rule{eval: func() *Validation {
+		var validations []*Validation
+		for _, key := range sortedKeys(b.value) {
+			if v := fn(key, b.value[key], mapField(b.field, key)); v != nil {
+				validations = append(validations, v)
+			}
+		}
+		return combine(b.field, validations)
+	}})
+	return b
+}
+
+// -----------------------------------------------------------------------------
+// String Map Builder (with typed Each)
+// -----------------------------------------------------------------------------
+
+// StrMapBuilder provides fluent validation for maps of strings to strings,
+// such as labels and headers.
+type StrMapBuilder struct {
+	value map[string]string
+	field string
+	rules []rule
+	bail  bool
+}
+
+// StrMap creates a new string map validation builder.
+func StrMap(v map[string]string, field string) *StrMapBuilder {
+	return &StrMapBuilder{value: v, field: field}
+}
+
+// V returns the combined validation result.
+func (b *StrMapBuilder) V() *Validation {
+	return evaluate(b.field, b.rules, b.bail)
+}
+
+// When conditionally applies validations. If cond is false, the steps fn adds
+// are not run but are still recorded as applied.
+func (b *StrMapBuilder) When(cond bool, fn func(*StrMapBuilder)) *StrMapBuilder {
+	if cond {
+		fn(b)
+		return b
+	}
+	skipped := &StrMapBuilder{value: b.value, field: b.field}
+	fn(skipped)
+	b.rules = append(b.rules, declared(b.field, skipped.rules)...)
+	return b
+}
+
+// Bail stops reporting errors after the first failed step, so an empty value
+// reports only "is required". Later steps are still recorded as applied.
+func (b *StrMapBuilder) Bail() *StrMapBuilder {
+	b.bail = true
+	return b
+}
+
+// Check validates the value with a predicate, failing with message when it returns false.
+// The validator is tracked as name.
+func (b *StrMapBuilder) Check(name string, fn func(v map[string]string) bool, message string) *StrMapBuilder {
+	b.rules = append(b.rules, rule{validators: []string{name}, eval: func() *Validation { return predicate(b.value, b.field, name, fn, message) }})
+	return b
+}
+
+// Custom applies a validation function to the value and field name.
+func (b *StrMapBuilder) Custom(fn func(v map[string]string, field string) *Validation) *StrMapBuilder {
+	b.rules = append(b.rules, rule{eval: func() *Validation { return fn(b.value, b.field) }})
+	return b
+}
+
+// Msg replaces the error message of the most recently added validation.
+// See [WithMessage].
+func (b *StrMapBuilder) Msg(message string) *StrMapBuilder {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithMessage(v, message) })
+	return b
+}
+
+// Code replaces the error code of the most recently added validation.
+// See [WithCode].
+func (b *StrMapBuilder) Code(code string) *StrMapBuilder {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithCode(v, code) })
+	return b
+}
+
+// NotEmpty validates that the map is not empty.
+func (b *StrMapBuilder) NotEmpty() *StrMapBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"required"}, eval: func() *Validation { return NotEmptyMap(b.value, b.field) }})
+	return b
+}
+
+// Empty validates that the map is empty.
+func (b *StrMapBuilder) Empty() *StrMapBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"empty"}, eval: func() *Validation { return EmptyMap(b.value, b.field) }})
+	return b
+}
+
+// MinKeys validates the minimum number of keys.
+func (b *StrMapBuilder) MinKeys(n int) *StrMapBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"minkeys"}, eval: func() *Validation { return MinKeys(b.value, n, b.field) }})
+	return b
+}
+
+// MaxKeys validates the maximum number of keys.
+func (b *StrMapBuilder) MaxKeys(n int) *StrMapBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"maxkeys"}, eval: func() *Validation { return MaxKeys(b.value, n, b.field) }})
+	return b
+}
+
+// ExactKeys validates the exact number of keys.
+func (b *StrMapBuilder) ExactKeys(n int) *StrMapBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"len"}, eval: func() *Validation { return ExactKeys(b.value, n, b.field) }})
+	return b
+}
+
+// KeysBetween validates the number of keys is within a range.
+func (b *StrMapBuilder) KeysBetween(minKeys, maxKeys int) *StrMapBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"minkeys", "maxkeys"}, eval: func() *Validation { return KeysBetween(b.value, minKeys, maxKeys, b.field) }})
+	return b
+}
+
+// HasKey validates that the map contains the key.
+func (b *StrMapBuilder) HasKey(key string) *StrMapBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"haskey"}, eval: func() *Validation { return HasKey(b.value, key, b.field) }})
+	return b
+}
+
+// HasKeys validates that the map contains all the keys.
+func (b *StrMapBuilder) HasKeys(keys []string) *StrMapBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"haskeys"}, eval: func() *Validation { return HasKeys(b.value, keys, b.field) }})
+	return b
+}
+
+// HasAnyKey validates that the map contains at least one of the keys.
+func (b *StrMapBuilder) HasAnyKey(keys []string) *StrMapBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"hasanykey"}, eval: func() *Validation { return HasAnyKey(b.value, keys, b.field) }})
+	return b
+}
+
+// NotHasKey validates that the map does not contain the key.
+func (b *StrMapBuilder) NotHasKey(key string) *StrMapBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"nothaskey"}, eval: func() *Validation { return NotHasKey(b.value, key, b.field) }})
+	return b
+}
+
+// NotHasKeys validates that the map contains none of the keys.
+func (b *StrMapBuilder) NotHasKeys(keys []string) *StrMapBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"nothaskeys"}, eval: func() *Validation { return NotHasKeys(b.value, keys, b.field) }})
+	return b
+}
+
+// OnlyKeys validates that the map only contains keys from the allowed set.
+func (b *StrMapBuilder) OnlyKeys(allowed []string) *StrMapBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"onlykeys"}, eval: func() *Validation { return OnlyKeys(b.value, allowed, b.field) }})
+	return b
+}
+
+// UniqueValues validates that all values in the map are unique.
+func (b *StrMapBuilder) UniqueValues() *StrMapBuilder {
+	b.rules = append(b.rules, rule{validators: []string{"unique"}, eval: func() *Validation { return uniqueMapValues(b.value, b.field) }})
+	return b
+}
+
+// EachKey applies validations to each key via a StrBuilder.
+// Keys are visited in sorted order with field names "field[key]".
+func (b *StrMapBuilder) EachKey(fn func(*StrBuilder)) *StrMapBuilder {
+	b.rules = append(b.rules, rule{eval: func() *Validation {
+		var validations []*Validation
+		for _, key := range sortedKeys(b.value) {
+			sb := &StrBuilder{value: key, field: mapField(b.field, key)}
+			fn(sb)
+			if v := sb.V(); v != nil {
+				validations = append(validations, v)
+			}
+		}
+		return combine(b.field, validations)
+	}})
+	return b
+}
+
+// EachValue applies validations to each value via a StrBuilder.
+// Keys are visited in sorted order with field names "field[key]".
+func (b *StrMapBuilder) EachValue(fn func(*StrBuilder)) *StrMapBuilder {
+	b.rules = append(b.rules, rule{eval: func() *Validation {
+		var validations []*Validation
+		for _, key := range sortedKeys(b.value) {
+			sb := &StrBuilder{value: b.value[key], field: mapField(b.field, key)}
+			fn(sb)
+			if v := sb.V(); v != nil {
+				validations = append(validations, v)
+			}
+		}
+		return combine(b.field, validations)
+	}})
+	return b
+}
+
+// -----------------------------------------------------------------------------
+// Optional Map Builder
+// -----------------------------------------------------------------------------
+
+// OptMapBuilder provides fluent validation for optional map pointers.
+type OptMapBuilder[K comparable, V any] struct {
+	value *map[K]V
+	field string
+	rules []rule
+	bail  bool
+	skip  bool
+}
+
+// OptMap creates a new optional map validation builder.
+// If the pointer is nil, validations are skipped but still recorded as applied.
+func OptMap[K comparable, V any](v *map[K]V, field string) *OptMapBuilder[K, V] {
+	return &OptMapBuilder[K, V]{value: v, field: field, skip: v == nil}
+}
+
+// V returns the combined validation result.
+// If the value is nil, it passes and records the declared validators.
+func (b *OptMapBuilder[K, V]) V() *Validation {
+	if b.skip {
+		return evaluate(b.field, declared(b.field, b.rules), false)
+	}
+	return evaluate(b.field, b.rules, b.bail)
+}
+
+// When conditionally applies validations. If cond is false, the steps fn adds
+// are not run but are still recorded as applied.
+func (b *OptMapBuilder[K, V]) When(cond bool, fn func(*OptMapBuilder[K, V])) *OptMapBuilder[K, V] {
+	if cond {
+		fn(b)
+		return b
+	}
+	skipped := &OptMapBuilder[K, V]{value: b.value, field: b.field, skip: b.skip}
+	fn(skipped)
+	b.rules = append(b.rules, declared(b.field, skipped.rules)...)
+	return b
+}
+
+// Bail stops reporting errors after the first failed step, so an empty value
+// reports only "is required". Later steps are still recorded as applied.
+func (b *OptMapBuilder[K, V]) Bail() *OptMapBuilder[K, V] {
+	b.bail = true
+	return b
+}
+
+// Check validates the value with a predicate, failing with message when it returns false.
+// The validator is tracked as name.
+func (b *OptMapBuilder[K, V]) Check(name string, fn func(v map[K]V) bool, message string) *OptMapBuilder[K, V] {
+	b.rules = append(b.rules, rule{validators: []string{name}, eval: func() *Validation { return predicate(*b.value, b.field, name, fn, message) }})
+	return b
+}
+
+// Custom applies a validation function to the value and field name.
+func (b *OptMapBuilder[K, V]) Custom(fn func(v map[K]V, field string) *Validation) *OptMapBuilder[K, V] {
+	b.rules = append(b.rules, rule{eval: func() *Validation { return fn(*b.value, b.field) }})
+	return b
+}
+
+// Msg replaces the error message of the most recently added validation.
+// See [WithMessage].
+func (b *OptMapBuilder[K, V]) Msg(message string) *OptMapBuilder[K, V] {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithMessage(v, message) })
+	return b
+}
+
+// Code replaces the error code of the most recently added validation.
+// See [WithCode].
+func (b *OptMapBuilder[K, V]) Code(code string) *OptMapBuilder[K, V] {
+	b.rules = wrapLast(b.rules, func(v *Validation) *Validation { return WithCode(v, code) })
+	return b
+}
+
+// NotEmpty validates that the map is not empty.
+func (b *OptMapBuilder[K, V]) NotEmpty() *OptMapBuilder[K, V] {
+	b.rules = append(b.rules, rule{validators: []string{"required"}, eval: func() *Validation { return NotEmptyMap(*b.value, b.field) }})
+	return b
+}
+
+// Empty validates that the map is empty.
+func (b *OptMapBuilder[K, V]) Empty() *OptMapBuilder[K, V] {
+	b.rules = append(b.rules, rule{validators: []string{"empty"}, eval: func() *Validation { return EmptyMap(*b.value, b.field) }})
+	return b
+}
+
+// MinKeys validates the minimum number of keys.
+func (b *OptMapBuilder[K, V]) MinKeys(n int) *OptMapBuilder[K, V] {
+	b.rules = append(b.rules, rule{validators: []string{"minkeys"}, eval: func() *Validation { return MinKeys(*b.value, n, b.field) }})
+	return b
+}
+
+// MaxKeys validates the maximum number of keys.
+func (b *OptMapBuilder[K, V]) MaxKeys(n int) *OptMapBuilder[K, V] {
+	b.rules = append(b.rules, rule{validators: []string{"maxkeys"}, eval: func() *Validation { return MaxKeys(*b.value, n, b.field) }})
+	return b
+}
+
+// ExactKeys validates the exact number of keys.
+func (b *OptMapBuilder[K, V]) ExactKeys(n int) *OptMapBuilder[K, V] {
+	b.rules = append(b.rules, rule{validators: []string{"len"}, eval: func() *Validation { return ExactKeys(*b.value, n, b.field) }})
+	return b
+}
+
+// KeysBetween validates the number of keys is within a range.
+func (b *OptMapBuilder[K, V]) KeysBetween(minKeys, maxKeys int) *OptMapBuilder[K, V] {
+	b.rules = append(b.rules, rule{validators: []string{"minkeys", "maxkeys"}, eval: func() *Validation { return KeysBetween(*b.value, minKeys, maxKeys, b.field) }})
+	return b
+}
+
+// HasKey validates that the map contains the key.
+func (b *OptMapBuilder[K, V]) HasKey(key K) *OptMapBuilder[K, V] {
+	b.rules = append(b.rules, rule{validators: []string{"haskey"}, eval: func() *Validation { return HasKey(*b.value, key, b.field) }})
+	return b
+}
+
+// HasKeys validates that the map contains all the keys.
+func (b *OptMapBuilder[K, V]) HasKeys(keys []K) *OptMapBuilder[K, V] {
+	b.rules = append(b.rules, rule{validators: []string{"haskeys"}, eval: func() *Validation { return HasKeys(*b.value, keys, b.field) }})
+	return b
+}
+
+// HasAnyKey validates that the map contains at least one of the keys.
+func (b *OptMapBuilder[K, V]) HasAnyKey(keys []K) *OptMapBuilder[K, V] {
+	b.rules = append(b.rules, rule{validators: []string{"hasanykey"}, eval: func() *Validation { return HasAnyKey(*b.value, keys, b.field) }})
+	return b
+}
+
+// NotHasKey validates that the map does not contain the key.
+func (b *OptMapBuilder[K, V]) NotHasKey(key K) *OptMapBuilder[K, V] {
+	b.rules = append(b.rules, rule{validators: []string{"nothaskey"}, eval: func() *Validation { return NotHasKey(*b.value, key, b.field) }})
+	return b
+}
+
+// NotHasKeys validates that the map contains none of the keys.
+func (b *OptMapBuilder[K, V]) NotHasKeys(keys []K) *OptMapBuilder[K, V] {
+	b.rules = append(b.rules, rule{validators: []string{"nothaskeys"}, eval: func() *Validation { return NotHasKeys(*b.value, keys, b.field) }})
+	return b
+}
+
+// OnlyKeys validates that the map only contains keys from the allowed set.
+func (b *OptMapBuilder[K, V]) OnlyKeys(allowed []K) *OptMapBuilder[K, V] {
+	b.rules = append(b.rules, rule{validators: []string{"onlykeys"}, eval: func() *Validation { return OnlyKeys(*b.value, allowed, b.field) }})
+	return b
+}
+
+// UniqueValues validates that all values in the map are unique.
+// Values that cannot be compared, such as slices, fail with an error.
+func (b *OptMapBuilder[K, V]) UniqueValues() *OptMapBuilder[K, V] {
+	b.rules = append(b.rules, rule{validators: []string{"unique"}, eval: func() *Validation { return uniqueMapValues(*b.value, b.field) }})
+	return b
+}
+
+// EachKey applies a validation to each key, collecting results.
+// Keys are visited in sorted order; the function receives the field name "field[key]".
+func (b *OptMapBuilder[K, V]) EachKey(fn func(k K, field string) *Validation) *OptMapBuilder[K, V] {
+	b.rules = append(b.rules, rule{eval: func() *Validation {
+		var validations []*Validation
+		for _, key := range sortedKeys(*b.value) {
+			if v := fn(key, mapField(b.field, key)); v != nil {
+				validations = append(validations, v)
+			}
+		}
+		return combine(b.field, validations)
+	}})
+	return b
+}
+
+// EachValue applies a validation to each value, collecting results.
+// Keys are visited in sorted order; the function receives the field name "field[key]".
+func (b *OptMapBuilder[K, V]) EachValue(fn func(v V, field string) *Validation) *OptMapBuilder[K, V] {
+	b.rules = append(b.rules, rule{eval: func() *Validation {
+		var validations []*Validation
+		for _, key := range sortedKeys(*b.value) {
+			if v := fn((*b.value)[key], mapField(b.field, key)); v != nil {
+				validations = append(validations, v)
+			}
+		}
+		return combine(b.field, validations)
+	}})
+	return b
+}
+
+// EachEntry applies a validation to each key-value pair, collecting results.
+// Keys are visited in sorted order; the function receives the field name "field[key]".
+func (b *OptMapBuilder[K, V]) EachEntry(fn func(k K, v V, field string) *Validation) *OptMapBuilder[K, V] {
+	b.rules = append(b.rules, rule{eval: func() *Validation {
+		var validations []*Validation
+		for _, key := range sortedKeys(*b.value) {
+			if v := fn(key, (*b.value)[key], mapField(b.field, key)); v != nil {
+				validations = append(validations, v)
+			}
+		}
+		return combine(b.field, validations)
+	}})
+	return b
+}
@@ -3,7 +3,10 @@ package check
 import (
 	"errors"
 	"regexp"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestStrBuilder(t *testing.T) {
@@ -89,8 +92,11 @@ func TestOptStrBuilder(t *testing.T) {
 	t.Run("nil pointer skips validation", func(t *testing.T) {
 		var name *string
 		v := OptStr(name, "name").MaxLen(100).V()
-		if v != nil {
-			t.Errorf("expected nil for optional nil field, got: %v", v)
+		if v.Failed() {
+			t.Errorf("expected pass for optional nil field, got: %v", v)
+		}
+		if got := All(v).ValidatorsFor("name"); !slices.Equal(got, []string{"max"}) {
+			t.Errorf("expected skipped step to be recorded, got: %v", got)
 		}
 	})
 
@@ -177,8 +183,11 @@ func TestOptNumBuilder(t *testing.T) {
 	t.Run("nil pointer skips validation", func(t *testing.T) {
 		var age *int
 		v := OptNum(age, "age").Min(0).Max(120).V()
-		if v != nil {
-			t.Errorf("expected nil for optional nil field, got: %v", v)
+		if v.Failed() {
+			t.Errorf("expected pass for optional nil field, got: %v", v)
+		}
+		if got := All(v).ValidatorsFor("age"); !slices.Equal(got, []string{"min", "max"}) {
+			t.Errorf("expected skipped steps to be recorded, got: %v", got)
 		}
 	})
 
@@ -658,8 +667,11 @@ func TestOptStrBuilderMethods(t *testing.T) {
 		v := OptStr(val, "f").When(true, func(b *OptStrBuilder) {
 			b.MinLen(10)
 		}).V()
-		if v != nil {
-			t.Errorf("expected nil for nil value, got: %v", v)
+		if v.Failed() {
+			t.Errorf("expected pass for nil value, got: %v", v)
+		}
+		if !All(v).HasValidator("f", "min") {
+			t.Error("expected skipped step to be recorded")
 		}
 	})
 
@@ -1084,7 +1096,7 @@ func TestSliceBuilderExtended(t *testing.T) {
 		_ = Slice(items, "f").Each(func(_ int, _ string) *Validation {
 			count++
 			return nil
-		}).V()
+		}).V().Err()
 		if count != 3 {
 			t.Errorf("expected 3 iterations, got %d", count)
 		}
@@ -1161,8 +1173,11 @@ func TestOptIntBuilder(t *testing.T) {
 	t.Run("nil skips validation", func(t *testing.T) {
 		var val *int
 		v := OptInt(val, "f").Min(0).V()
-		if v != nil {
-			t.Errorf("expected nil, got: %v", v)
+		if v.Failed() {
+			t.Errorf("expected pass, got: %v", v)
+		}
+		if got := All(v).ValidatorsFor("f"); !slices.Equal(got, []string{"min"}) {
+			t.Errorf("expected skipped step to be recorded, got: %v", got)
 		}
 	})
 
@@ -1254,8 +1269,11 @@ func TestOptSliceBuilder(t *testing.T) {
 	t.Run("nil skips validation", func(t *testing.T) {
 		var items *[]int
 		v := OptSlice(items, "f").MinItems(1).V()
-		if v != nil {
-			t.Errorf("expected nil, got: %v", v)
+		if v.Failed() {
+			t.Errorf("expected pass, got: %v", v)
+		}
+		if got := All(v).ValidatorsFor("f"); !slices.Equal(got, []string{"minitems"}) {
+			t.Errorf("expected skipped step to be recorded, got: %v", got)
 		}
 	})
 
@@ -1327,8 +1345,11 @@ func TestOptStrSliceBuilder(t *testing.T) {
 	t.Run("nil skips validation", func(t *testing.T) {
 		var items *[]string
 		v := OptStrSlice(items, "f").MinItems(1).V()
-		if v != nil {
-			t.Errorf("expected nil, got: %v", v)
+		if v.Failed() {
+			t.Errorf("expected pass, got: %v", v)
+		}
+		if got := All(v).ValidatorsFor("f"); !slices.Equal(got, []string{"minitems"}) {
+			t.Errorf("expected skipped step to be recorded, got: %v", got)
 		}
 	})
 
@@ -1411,3 +1432,784 @@ func TestOptStrSliceBuilder(t *testing.T) {
 		}
 	})
 }
+
+func TestTimeBuilder(t *testing.T) {
+	now := time.Date(2024, 6, 12, 10, 0, 0, 0, time.UTC) // Wednesday
+	clock := ClockFunc(func() time.Time { return now })
+
+	t.Run("passing chain", func(t *testing.T) {
+		v := Time(now, "start").
+			Required().
+			After(now.Add(-time.Hour)).
+			Before(now.Add(time.Hour)).
+			AfterOrEqual(now).
+			BeforeOrEqual(now).
+			Between(now.Add(-time.Hour), now.Add(time.Hour)).
+			BetweenExclusive(now.Add(-time.Hour), now.Add(time.Hour)).
+			WithinDurationOf(time.Minute, now).
+			SameDay(now).SameMonth(now).SameYear(now).
+			Weekday(time.Wednesday).
+			WeekdayIn([]time.Weekday{time.Wednesday}).
+			NotWeekend().
+			InTimezone(time.UTC).
+			V()
+		if v.Failed() {
+			t.Errorf("expected pass, got: %v", v.err)
+		}
+		for _, name := range []string{"required", "after", "before", "lte", "gte", "gt", "lt", "within", "sameday", "weekday", "notweekend", "timezone"} {
+			if !All(v).HasValidator("start", name) {
+				t.Errorf("expected %s to be tracked", name)
+			}
+		}
+	})
+
+	t.Run("failures", func(t *testing.T) {
+		tests := []struct {
+			name string
+			fn   func(*TimeBuilder)
+		}{
+			{"Required", func(b *TimeBuilder) { b.Required() }},
+			{"Before", func(b *TimeBuilder) { b.Before(now.Add(-2 * time.Hour)) }},
+			{"After", func(b *TimeBuilder) { b.After(now) }},
+			{"Between", func(b *TimeBuilder) { b.Between(now, now.Add(time.Hour)) }},
+			{"IsWeekend", func(b *TimeBuilder) { b.IsWeekend() }},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				var b *TimeBuilder
+				if tt.name == "Required" {
+					b = Time(time.Time{}, "f")
+				} else {
+					b = Time(now.Add(-time.Hour), "f")
+				}
+				tt.fn(b)
+				if !b.V().Failed() {
+					t.Error("expected failure")
+				}
+			})
+		}
+	})
+
+	t.Run("Zero", func(t *testing.T) {
+		if Time(time.Time{}, "f").Zero().V().Failed() {
+			t.Error("expected pass")
+		}
+	})
+
+	t.Run("clock", func(t *testing.T) {
+		past := now.Add(-time.Minute)
+		v := Time(past, "f").Clock(clock).
+			BeforeNow().InPast().BeforeOrEqualNow().
+			WithinDuration(2 * time.Minute).
+			V()
+		if v.Failed() {
+			t.Errorf("expected pass, got: %v", v.err)
+		}
+
+		v = Time(past, "f").Clock(clock).AfterNow().InFuture().AfterOrEqualNow().WithinDuration(time.Second).V()
+		if errs := GetFieldErrors(All(v)); len(errs) != 4 {
+			t.Errorf("expected 4 errors, got %d: %v", len(errs), v.err)
+		}
+	})
+
+	t.Run("system clock by default", func(t *testing.T) {
+		if Time(time.Now().Add(time.Hour), "f").AfterNow().V().Failed() {
+			t.Error("expected pass")
+		}
+	})
+
+	t.Run("Clock applies to the steps that follow it", func(t *testing.T) {
+		later := ClockFunc(func() time.Time { return now.Add(-2 * time.Hour) })
+		past := now.Add(-time.Hour)
+		b := Time(past, "f").Clock(clock).BeforeNow()
+		v := b.V()
+		b.Clock(later).AfterNow()
+		if v.Failed() {
+			t.Errorf("expected earlier V to keep its clock, got: %v", v)
+		}
+		if after := b.V(); after.Failed() {
+			t.Errorf("expected only the later step to use the new clock, got: %v", after)
+		}
+	})
+
+	t.Run("When conditional", func(t *testing.T) {
+		v := Time(now, "f").When(false, func(b *TimeBuilder) {
+			b.Before(now)
+		}).V()
+		if v.Failed() {
+			t.Errorf("expected pass, got: %v", v)
+		}
+		if !All(v).HasValidator("f", "before") {
+			t.Error("expected skipped step to be recorded")
+		}
+		v = Time(now, "f").When(true, func(b *TimeBuilder) {
+			b.Before(now)
+		}).V()
+		if !v.Failed() {
+			t.Error("expected failure")
+		}
+	})
+}
+
+func TestOptTimeBuilder(t *testing.T) {
+	now := time.Date(2024, 6, 12, 10, 0, 0, 0, time.UTC)
+	clock := ClockFunc(func() time.Time { return now })
+
+	t.Run("Clock applies to the steps that follow it", func(t *testing.T) {
+		later := ClockFunc(func() time.Time { return now.Add(-2 * time.Hour) })
+		past := now.Add(-time.Hour)
+		v := OptTime(&past, "f").Clock(clock).BeforeNow().Clock(later).V()
+		if v.Failed() {
+			t.Errorf("expected BeforeNow to keep its clock, got: %v", v)
+		}
+	})
+
+	t.Run("nil skips validation", func(t *testing.T) {
+		var val *time.Time
+		v := OptTime(val, "f").Clock(clock).Required().BeforeNow().When(true, func(b *OptTimeBuilder) {
+			b.After(now)
+		}).V()
+		if v.Failed() {
+			t.Errorf("expected pass, got: %v", v)
+		}
+		if got := All(v).ValidatorsFor("f"); !slices.Equal(got, []string{"required", "past", "after"}) {
+			t.Errorf("expected skipped steps to be recorded, got: %v", got)
+		}
+	})
+
+	t.Run("non-nil validates", func(t *testing.T) {
+		val := now.Add(time.Hour)
+		v := OptTime(&val, "f").Clock(clock).
+			Required().
+			AfterNow().InFuture().AfterOrEqualNow().
+			WithinDuration(2*time.Hour).
+			After(now).AfterOrEqual(now).
+			Before(now.Add(2*time.Hour)).BeforeOrEqual(now.Add(2*time.Hour)).
+			Between(now, now.Add(2*time.Hour)).
+			BetweenExclusive(now, now.Add(2*time.Hour)).
+			WithinDurationOf(time.Hour, now).
+			SameDay(now).SameMonth(now).SameYear(now).
+			Weekday(time.Wednesday).WeekdayIn([]time.Weekday{time.Wednesday}).
+			NotWeekend().
+			InTimezone(time.UTC).
+			V()
+		if v == nil {
+			t.Fatal("expected validation, got nil")
+		}
+		if v.Failed() {
+			t.Errorf("expected pass, got: %v", v.err)
+		}
+	})
+
+	t.Run("non-nil fails", func(t *testing.T) {
+		val := now.Add(-time.Hour)
+		v := OptTime(&val, "f").Clock(clock).BeforeOrEqualNow().InPast().IsWeekend().Zero().V()
+		if errs := GetFieldErrors(All(v)); len(errs) != 2 {
+			t.Errorf("expected 2 errors, got %d: %v", len(errs), v.err)
+		}
+	})
+}
+
+func TestMapBuilder(t *testing.T) {
+	labels := map[string]int{"env": 1, "app": 2, "tier": 3}
+
+	t.Run("passing chain", func(t *testing.T) {
+		v := Map(labels, "labels").
+			NotEmpty().MinKeys(1).MaxKeys(5).ExactKeys(3).KeysBetween(1, 3).
+			HasKey("env").HasKeys([]string{"env", "app"}).HasAnyKey([]string{"x", "app"}).
+			NotHasKey("x").NotHasKeys([]string{"x", "y"}).
+			OnlyKeys([]string{"env", "app", "tier"}).
+			UniqueValues().
+			V()
+		if v.Failed() {
+			t.Errorf("expected pass, got: %v", v.err)
+		}
+		r := All(v)
+		for _, name := range []string{"required", "minkeys", "maxkeys", "len", "haskey", "onlykeys", "unique"} {
+			if !r.HasValidator("labels", name) {
+				t.Errorf("expected %s to be tracked", name)
+			}
+		}
+	})
+
+	t.Run("UniqueValues with uncomparable values", func(t *testing.T) {
+		v := Map(map[string][]string{"a": {"x"}, "b": {"x"}}, "f").UniqueValues().V()
+		if !v.Failed() {
+			t.Fatal("expected failure")
+		}
+		if v.Err().Error() != "f: values of type []string cannot be compared for uniqueness" {
+			t.Errorf("unexpected error: %v", v.Err())
+		}
+		var fe *FieldError
+		if !errors.As(v.Err(), &fe) {
+			t.Fatalf("expected *FieldError, got %T", v.Err())
+		}
+		if fe.Code != "unique" || fe.MessageKey() != "unique.uncomparable" || fe.Params["type"] != "[]string" {
+			t.Errorf("unexpected field error: %+v", fe)
+		}
+		if errs := GetFieldErrors(All(v)); len(errs) != 1 {
+			t.Errorf("expected one field error, got %v", errs)
+		}
+
+		m := map[string]any{"a": []int{1}, "b": 2}
+		if !OptMap(&m, "f").UniqueValues().V().Failed() {
+			t.Error("expected failure for uncomparable dynamic value")
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		if !Map(labels, "labels").Empty().V().Failed() {
+			t.Error("expected failure")
+		}
+	})
+
+	t.Run("EachValue paths are sorted", func(t *testing.T) {
+		v := Map(labels, "labels").EachValue(func(n int, field string) *Validation {
+			return Max(n, 0, field)
+		}).V()
+		names := FieldNames(All(v))
+		want := []string{"labels[app]", "labels[env]", "labels[tier]"}
+		if len(names) != len(want) {
+			t.Fatalf("expected %v, got %v", want, names)
+		}
+		for i := range want {
+			if names[i] != want[i] {
+				t.Errorf("expected %v, got %v", want, names)
+			}
+		}
+	})
+
+	t.Run("EachKey and EachEntry", func(t *testing.T) {
+		v := Map(labels, "labels").
+			EachKey(func(k string, field string) *Validation { return MaxLen(k, 3, field) }).
+			EachEntry(func(k string, n int, field string) *Validation {
+				if k == "env" {
+					return Max(n, 0, field)
+				}
+				return nil
+			}).
+			V()
+		r := All(v)
+		if !HasField(r, "labels[tier]") || !HasField(r, "labels[env]") || HasField(r, "labels[app]") {
+			t.Errorf("unexpected errors: %v", r.Err())
+		}
+		if !r.HasValidator("labels", "max") {
+			t.Error("expected element validators to be tracked on the map")
+		}
+	})
+
+	t.Run("integer keys sort numerically", func(t *testing.T) {
+		v := Map(map[int]string{10: "", 2: "", 1: ""}, "m").EachValue(func(s string, field string) *Validation {
+			return Required(s, field)
+		}).V()
+		names := FieldNames(All(v))
+		if len(names) != 3 || names[0] != "m[1]" || names[1] != "m[2]" || names[2] != "m[10]" {
+			t.Errorf("unexpected order: %v", names)
+		}
+	})
+
+	t.Run("When conditional", func(t *testing.T) {
+		v := Map(labels, "labels").When(true, func(b *MapBuilder[string, int]) {
+			b.MaxKeys(1)
+		}).V()
+		if !v.Failed() {
+			t.Error("expected failure")
+		}
+	})
+}
+
+func TestStrMapBuilder(t *testing.T) {
+	labels := map[string]string{"env": "prod", "App": "web", "tier": ""}
+
+	t.Run("map checks", func(t *testing.T) {
+		v := StrMap(labels, "labels").
+			NotEmpty().MinKeys(1).MaxKeys(3).ExactKeys(3).KeysBetween(1, 3).
+			HasKey("env").HasKeys([]string{"env"}).HasAnyKey([]string{"env"}).
+			NotHasKey("x").NotHasKeys([]string{"x"}).
+			OnlyKeys([]string{"env", "App", "tier"}).
+			UniqueValues().
+			V()
+		if v.Failed() {
+			t.Errorf("expected pass, got: %v", v.err)
+		}
+		if !StrMap(labels, "labels").Empty().V().Failed() {
+			t.Error("expected Empty to fail")
+		}
+	})
+
+	t.Run("EachKey and EachValue", func(t *testing.T) {
+		r := All(StrMap(labels, "labels").
+			EachKey(func(b *StrBuilder) { b.LowerCase() }).
+			EachValue(func(b *StrBuilder) { b.Required() }).
+			V())
+		want := "labels[App]: must be lowercase; labels[tier]: is required"
+		if r.Error() != want {
+			t.Errorf("got %q, want %q", r.Error(), want)
+		}
+		if !r.HasValidator("labels", "lowercase") || !r.HasValidator("labels", "required") {
+			t.Errorf("expected element validators to be tracked on the map, got %v", r.Applied())
+		}
+	})
+
+	t.Run("deterministic", func(t *testing.T) {
+		build := func() string {
+			return All(StrMap(labels, "labels").EachValue(func(b *StrBuilder) { b.MinLen(5) }).V()).Error()
+		}
+		first := build()
+		for i := 0; i < 20; i++ {
+			if build() != first {
+				t.Fatal("error output changed between runs")
+			}
+		}
+	})
+
+	t.Run("When conditional", func(t *testing.T) {
+		v := StrMap(labels, "labels").When(false, func(b *StrMapBuilder) {
+			b.MaxKeys(1)
+		}).V()
+		if v.Failed() {
+			t.Errorf("expected pass, got %v", v)
+		}
+		if !All(v).HasValidator("labels", "maxkeys") {
+			t.Error("expected skipped step to be recorded")
+		}
+	})
+}
+
+func TestOptMapBuilder(t *testing.T) {
+	t.Run("nil skips validation", func(t *testing.T) {
+		var m *map[string]int
+		v := OptMap(m, "m").NotEmpty().HasKey("a").
+			EachKey(func(string, string) *Validation { t.Error("unexpected call"); return nil }).
+			EachValue(func(int, string) *Validation { t.Error("unexpected call"); return nil }).
+			EachEntry(func(string, int, string) *Validation { t.Error("unexpected call"); return nil }).
+			When(true, func(b *OptMapBuilder[string, int]) { b.MinKeys(1) }).
+			V()
+		if v.Failed() {
+			t.Errorf("expected pass, got: %v", v)
+		}
+		if got := All(v).ValidatorsFor("m"); !slices.Equal(got, []string{"required", "haskey", "minkeys"}) {
+			t.Errorf("expected skipped steps to be recorded, got: %v", got)
+		}
+	})
+
+	t.Run("non-nil validates", func(t *testing.T) {
+		m := map[string]int{"b": 2, "a": 1}
+		v := OptMap(&m, "m").
+			NotEmpty().MinKeys(1).MaxKeys(2).ExactKeys(2).KeysBetween(1, 2).
+			HasKey("a").HasKeys([]string{"a", "b"}).HasAnyKey([]string{"a"}).
+			NotHasKey("c").NotHasKeys([]string{"c"}).OnlyKeys([]string{"a", "b"}).
+			UniqueValues().
+			EachKey(func(k string, field string) *Validation { return MaxLen(k, 1, field) }).
+			EachEntry(func(_ string, n int, field string) *Validation { return Positive(n, field) }).
+			V()
+		if v == nil {
+			t.Fatal("expected validation, got nil")
+		}
+		if v.Failed() {
+			t.Errorf("expected pass, got: %v", v.err)
+		}
+	})
+
+	t.Run("non-nil fails", func(t *testing.T) {
+		m := map[string]int{"b": 2, "a": 2}
+		r := All(OptMap(&m, "m").Empty().UniqueValues().EachValue(func(n int, field string) *Validation {
+			return Max(n, 1, field)
+		}).V())
+		if len(GetFieldErrors(r)) != 4 || FieldNames(r)[2] != "m[a]" {
+			t.Errorf("unexpected errors: %v", r.Err())
+		}
+	})
+}
+
+func TestBuilderCheckAndCustom(t *testing.T) {
+	isEven := func(n int) bool { return n%2 == 0 }
+
+	t.Run("Check passes and fails", func(t *testing.T) {
+		v := Str("ABC-123", "sku").Check("sku", func(s string) bool { return len(s) == 7 }, "must be a valid SKU").V()
+		if v.Failed() {
+			t.Errorf("expected pass, got %v", v.err)
+		}
+		v = Str("bad", "sku").Check("sku", func(s string) bool { return len(s) == 7 }, "must be a valid SKU").V()
+		var fe *FieldError
+		if !errors.As(v.Err(), &fe) || fe.Message != "must be a valid SKU" || fe.Code != "sku" || fe.Field != "sku" {
+			t.Errorf("unexpected error: %v", v.Err())
+		}
+	})
+
+	t.Run("Custom", func(t *testing.T) {
+		v := Int(3, "n").Custom(func(n int, field string) *Validation {
+			return Max(n, 2, field)
+		}).Custom(func(int, string) *Validation { return nil }).V()
+		if !v.Failed() || !All(v).HasValidator("n", "max") {
+			t.Errorf("expected tracked failure, got %v", v)
+		}
+	})
+
+	t.Run("tracking satisfies Check coverage", func(t *testing.T) {
+		type product struct {
+			SKU string `json:"sku" validate:"required,sku"`
+		}
+		r := Check[product](Str("ABC-123", "sku").Required().Check("sku", func(s string) bool { return true }, "invalid").V())
+		if r.Err() != nil {
+			t.Errorf("unexpected error: %v", r.Err())
+		}
+	})
+
+	t.Run("every builder", func(t *testing.T) {
+		s, n, f := "x", 3, 1.5
+		ss, ns := []string{"a"}, []int{1}
+		now := time.Now()
+		m, sm := map[string]int{"a": 1}, map[string]string{"a": "b"}
+		fail := []*Validation{
+			Str(s, "f").Check("c", func(string) bool { return false }, "bad").V(),
+			OptStr(&s, "f").Check("c", func(string) bool { return false }, "bad").V(),
+			Num(f, "f").Check("c", func(float64) bool { return false }, "bad").V(),
+			Int(n, "f").Check("c", isEven, "bad").V(),
+			OptNum(&f, "f").Check("c", func(float64) bool { return false }, "bad").V(),
+			Slice(ns, "f").Check("c", func([]int) bool { return false }, "bad").V(),
+			StrSlice(ss, "f").Check("c", func([]string) bool { return false }, "bad").V(),
+			OptInt(&n, "f").Check("c", isEven, "bad").V(),
+			OptSlice(&ns, "f").Check("c", func([]int) bool { return false }, "bad").V(),
+			OptStrSlice(&ss, "f").Check("c", func([]string) bool { return false }, "bad").V(),
+			Time(now, "f").Check("c", func(time.Time) bool { return false }, "bad").V(),
+			OptTime(&now, "f").Check("c", func(time.Time) bool { return false }, "bad").V(),
+			Map(m, "f").Check("c", func(map[string]int) bool { return false }, "bad").V(),
+			StrMap(sm, "f").Check("c", func(map[string]string) bool { return false }, "bad").V(),
+			OptMap(&m, "f").Check("c", func(map[string]int) bool { return false }, "bad").V(),
+		}
+		for i, v := range fail {
+			if !v.Failed() || !All(v).HasValidator("f", "c") {
+				t.Errorf("builder %d: expected tracked failure, got %v", i, v)
+			}
+		}
+
+		custom := func(field string) *Validation { return Required("", field) }
+		fail = []*Validation{
+			Str(s, "f").Custom(func(_ string, field string) *Validation { return custom(field) }).V(),
+			OptStr(&s, "f").Custom(func(_ string, field string) *Validation { return custom(field) }).V(),
+			Num(f, "f").Custom(func(_ float64, field string) *Validation { return custom(field) }).V(),
+			Int(n, "f").Custom(func(_ int, field string) *Validation { return custom(field) }).V(),
+			OptNum(&f, "f").Custom(func(_ float64, field string) *Validation { return custom(field) }).V(),
+			Slice(ns, "f").Custom(func(_ []int, field string) *Validation { return custom(field) }).V(),
+			StrSlice(ss, "f").Custom(func(_ []string, field string) *Validation { return custom(field) }).V(),
+			OptInt(&n, "f").Custom(func(_ int, field string) *Validation { return custom(field) }).V(),
+			OptSlice(&ns, "f").Custom(func(_ []int, field string) *Validation { return custom(field) }).V(),
+			OptStrSlice(&ss, "f").Custom(func(_ []string, field string) *Validation { return custom(field) }).V(),
+			Time(now, "f").Custom(func(_ time.Time, field string) *Validation { return custom(field) }).V(),
+			OptTime(&now, "f").Custom(func(_ time.Time, field string) *Validation { return custom(field) }).V(),
+			Map(m, "f").Custom(func(_ map[string]int, field string) *Validation { return custom(field) }).V(),
+			StrMap(sm, "f").Custom(func(_ map[string]string, field string) *Validation { return custom(field) }).V(),
+			OptMap(&m, "f").Custom(func(_ map[string]int, field string) *Validation { return custom(field) }).V(),
+		}
+		for i, v := range fail {
+			if !v.Failed() || !All(v).HasValidator("f", "required") {
+				t.Errorf("builder %d: expected tracked failure, got %v", i, v)
+			}
+		}
+	})
+
+	t.Run("Opt builders skip on nil", func(t *testing.T) {
+		never := func() { t.Error("unexpected call") }
+		var (
+			s  *string
+			n  *int
+			f  *float64
+			ns *[]int
+			ss *[]string
+			tm *time.Time
+			m  *map[string]int
+		)
+		skipped := []*Validation{
+			OptStr(s, "f").Check("c", func(string) bool { never(); return false }, "bad").Custom(func(string, string) *Validation { never(); return nil }).V(),
+			OptNum(f, "f").Check("c", func(float64) bool { never(); return false }, "bad").Custom(func(float64, string) *Validation { never(); return nil }).V(),
+			OptInt(n, "f").Check("c", func(int) bool { never(); return false }, "bad").Custom(func(int, string) *Validation { never(); return nil }).V(),
+			OptSlice(ns, "f").Check("c", func([]int) bool { never(); return false }, "bad").Custom(func([]int, string) *Validation { never(); return nil }).V(),
+			OptStrSlice(ss, "f").Check("c", func([]string) bool { never(); return false }, "bad").Custom(func([]string, string) *Validation { never(); return nil }).V(),
+			OptTime(tm, "f").Check("c", func(time.Time) bool { never(); return false }, "bad").Custom(func(time.Time, string) *Validation { never(); return nil }).V(),
+			OptMap(m, "f").Check("c", func(map[string]int) bool { never(); return false }, "bad").Custom(func(map[string]int, string) *Validation { never(); return nil }).V(),
+		}
+		for i, v := range skipped {
+			if v.Failed() {
+				t.Errorf("builder %d: expected pass, got %v", i, v)
+			}
+			if !All(v).HasValidator("f", "c") {
+				t.Errorf("builder %d: expected skipped check to be recorded", i)
+			}
+		}
+	})
+}
+
+func TestBuilderMsgAndCode(t *testing.T) {
+	t.Run("applies to the most recent step", func(t *testing.T) {
+		r := All(Str("", "sku").
+			Required().
+			Match(regexp.MustCompile(`^[A-Z]{3}$`)).Msg("must be three capital letters").Code("sku_format").
+			V())
+		errs := GetFieldErrors(r)
+		if len(errs) != 2 {
+			t.Fatalf("expected 2 errors, got %v", r.Err())
+		}
+		if errs[0].Message != "is required" || errs[0].Code != "required" {
+			t.Errorf("earlier step changed: %+v", errs[0])
+		}
+		if errs[1].Message != "must be three capital letters" || errs[1].Code != "sku_format" {
+			t.Errorf("unexpected: %+v", errs[1])
+		}
+		if !r.HasValidator("sku", "pattern") {
+			t.Error("expected tracking to be preserved")
+		}
+	})
+
+	t.Run("no steps", func(t *testing.T) {
+		if v := Int(1, "n").Msg("x").Code("y").V(); v != nil {
+			t.Errorf("expected nil, got %v", v)
+		}
+	})
+
+	t.Run("every builder", func(t *testing.T) {
+		n, f, s := 0, 0.0, ""
+		now := time.Time{}
+		ns, ss := []int{}, []string{}
+		m, sm := map[string]int{}, map[string]string{}
+		vs := []*Validation{
+			Str(s, "f").Required().Msg("custom").Code("c").V(),
+			OptStr(&s, "f").MinLen(1).Msg("custom").Code("c").V(),
+			Num(f, "f").GreaterThan(0).Msg("custom").Code("c").V(),
+			Int(n, "f").Positive().Msg("custom").Code("c").V(),
+			OptNum(&f, "f").GreaterThan(0).Msg("custom").Code("c").V(),
+			Slice(ns, "f").NotEmpty().Msg("custom").Code("c").V(),
+			StrSlice(ss, "f").NotEmpty().Msg("custom").Code("c").V(),
+			OptInt(&n, "f").Positive().Msg("custom").Code("c").V(),
+			OptSlice(&ns, "f").NotEmpty().Msg("custom").Code("c").V(),
+			OptStrSlice(&ss, "f").NotEmpty().Msg("custom").Code("c").V(),
+			Time(now, "f").Required().Msg("custom").Code("c").V(),
+			OptTime(&now, "f").Required().Msg("custom").Code("c").V(),
+			Map(m, "f").NotEmpty().Msg("custom").Code("c").V(),
+			StrMap(sm, "f").NotEmpty().Msg("custom").Code("c").V(),
+			OptMap(&m, "f").NotEmpty().Msg("custom").Code("c").V(),
+		}
+		for i, v := range vs {
+			errs := GetFieldErrors(All(v))
+			if len(errs) != 1 || errs[0].Message != "custom" || errs[0].Code != "c" {
+				t.Errorf("builder %d: unexpected errors %v", i, v.Err())
+			}
+		}
+	})
+}
+
+func TestBuilderBail(t *testing.T) {
+	t.Run("reports only the first failure", func(t *testing.T) {
+		r := All(Str("", "email").Bail().Required().Email().MaxLen(255).V())
+		if r.Error() != "email: is required" {
+			t.Errorf("unexpected: %s", r.Error())
+		}
+		for _, name := range []string{"required", "email", "max"} {
+			if !r.HasValidator("email", name) {
+				t.Errorf("expected %s to be recorded", name)
+			}
+		}
+	})
+
+	t.Run("without bail reports every failure", func(t *testing.T) {
+		r := All(Str("", "email").Required().Email().V())
+		if len(GetFieldErrors(r)) != 2 {
+			t.Errorf("expected 2 errors, got %v", r.Err())
+		}
+	})
+
+	t.Run("passing steps before the failure", func(t *testing.T) {
+		r := All(Str("not-an-email", "email").Bail().Required().Email().MinLen(50).V())
+		if r.Error() != "email: must be a valid email address" {
+			t.Errorf("unexpected: %s", r.Error())
+		}
+	})
+
+	t.Run("satisfies Check coverage", func(t *testing.T) {
+		type signup struct {
+			Email string `json:"email" validate:"required,email,max=255"`
+		}
+		r := Check[signup](Str("", "email").Bail().Required().Email().MaxLen(255).V())
+		if len(flatten(r.Err())) != 1 {
+			t.Errorf("expected only the required error, got %v", r.Err())
+		}
+	})
+
+	t.Run("every builder", func(t *testing.T) {
+		n, f, s := 0, 0.0, ""
+		now := time.Time{}
+		ns, ss := []int{}, []string{}
+		m, sm := map[string]int{}, map[string]string{}
+		vs := []*Validation{
+			Str(s, "f").Bail().Required().MinLen(1).V(),
+			OptStr(&s, "f").Bail().MinLen(1).MinLen(2).V(),
+			Num(f, "f").Bail().GreaterThan(0).Min(1).V(),
+			Int(n, "f").Bail().Positive().Min(1).V(),
+			OptNum(&f, "f").Bail().GreaterThan(0).Min(1).V(),
+			Slice(ns, "f").Bail().NotEmpty().MinItems(1).V(),
+			StrSlice(ss, "f").Bail().NotEmpty().MinItems(1).V(),
+			OptInt(&n, "f").Bail().Positive().Min(1).V(),
+			OptSlice(&ns, "f").Bail().NotEmpty().MinItems(1).V(),
+			OptStrSlice(&ss, "f").Bail().NotEmpty().MinItems(1).V(),
+			Time(now, "f").Bail().Required().After(time.Now()).V(),
+			OptTime(&now, "f").Bail().Required().After(time.Now()).V(),
+			Map(m, "f").Bail().NotEmpty().MinKeys(1).V(),
+			StrMap(sm, "f").Bail().NotEmpty().MinKeys(1).V(),
+			OptMap(&m, "f").Bail().NotEmpty().MinKeys(1).V(),
+		}
+		for i, v := range vs {
+			if errs := GetFieldErrors(All(v)); len(errs) != 1 {
+				t.Errorf("builder %d: expected 1 error, got %v", i, v.Err())
+			}
+			if len(v.validators) < 2 {
+				t.Errorf("builder %d: expected both steps recorded, got %v", i, v.validators)
+			}
+		}
+	})
+}
+
+func TestBuilderLazy(t *testing.T) {
+	counter := func(n *int) func(string) bool {
+		return func(string) bool {
+			*n++
+			return true
+		}
+	}
+
+	t.Run("evaluates when inspected", func(t *testing.T) {
+		calls := 0
+		v := Str("x", "f").Check("counted", counter(&calls), "fails").V()
+		if calls != 0 {
+			t.Fatalf("expected no calls before inspection, got %d", calls)
+		}
+		if v.Failed() {
+			t.Errorf("expected pass, got: %v", v.err)
+		}
+		_ = v.Err()
+		if calls != 1 {
+			t.Errorf("expected 1 call, got %d", calls)
+		}
+	})
+
+	t.Run("bail skips later steps", func(t *testing.T) {
+		calls := 0
+		r := All(Str("", "f").Bail().Required().Check("counted", counter(&calls), "fails").V())
+		if calls != 0 {
+			t.Errorf("expected skipped step, got %d calls", calls)
+		}
+		if !r.HasValidator("f", "counted") {
+			t.Error("expected skipped step to be recorded")
+		}
+	})
+
+	t.Run("bail skips custom and each steps", func(t *testing.T) {
+		calls := 0
+		_ = Slice([]int{1, 2}, "f").Bail().MinItems(5).Each(func(_ int, _ string) *Validation {
+			calls++
+			return nil
+		}).Custom(func(_ []int, _ string) *Validation {
+			calls++
+			return nil
+		}).V().Err()
+		if calls != 0 {
+			t.Errorf("expected skipped steps, got %d calls", calls)
+		}
+	})
+
+	t.Run("first stops evaluating after a failure", func(t *testing.T) {
+		calls := 0
+		r := First(
+			Str("", "a").Required().V(),
+			Str("x", "b").Check("counted", counter(&calls), "fails").V(),
+		)
+		if calls != 0 {
+			t.Errorf("expected later chain to be skipped, got %d calls", calls)
+		}
+		if r.HasValidator("b", "counted") {
+			t.Error("expected skipped chain not to be tracked")
+		}
+	})
+
+	t.Run("false when branch is never evaluated", func(t *testing.T) {
+		calls := 0
+		_ = Str("x", "f").Required().When(false, func(b *StrBuilder) {
+			b.Check("counted", counter(&calls), "fails")
+		}).V().Err()
+		if calls != 0 {
+			t.Errorf("expected no calls, got %d", calls)
+		}
+	})
+
+	t.Run("later changes to the builder do not affect V", func(t *testing.T) {
+		b := Str("", "f").Required()
+		v := b.V()
+		b.Msg("changed").MinLen(3)
+		if got := v.Error(); got != "f: is required" {
+			t.Errorf("unexpected error: %s", got)
+		}
+		if got := b.V().Error(); got != "f: changed; f: must be at least 3 characters" {
+			t.Errorf("unexpected builder error: %s", got)
+		}
+	})
+
+	t.Run("no rules gives nil", func(t *testing.T) {
+		if v := Str("x", "f").V(); v != nil {
+			t.Errorf("expected nil, got %v", v)
+		}
+	})
+}
+
+var benchPattern = regexp.MustCompile(`^([a-z0-9]+(-[a-z0-9]+)*\.)+[a-z]{2,}(/[\w\-.~%]*)*$`)
+
+// BenchmarkBuilderBail compares running every step with stopping at the first
+// failure. Passing values run every step either way; failing ones skip the
+// JSON parse with Bail.
+func BenchmarkBuilderBail(b *testing.B) {
+	doc := `{"items":[` + strings.Repeat(`{"id":1,"tags":["a","b"]},`, 200) + `{"id":2}]}`
+	for _, bc := range []struct {
+		name  string
+		value string
+	}{
+		{"passing", doc},
+		{"failing", ""},
+	} {
+		b.Run(bc.name+"/no-bail", func(b *testing.B) {
+			for b.Loop() {
+				_ = Str(bc.value, "f").Required().JSON().MaxLen(1 << 20).V().Err()
+			}
+		})
+		b.Run(bc.name+"/bail", func(b *testing.B) {
+			for b.Loop() {
+				_ = Str(bc.value, "f").Bail().Required().JSON().MaxLen(1 << 20).V().Err()
+			}
+		})
+	}
+}
+
+func BenchmarkFirst(b *testing.B) {
+	long := strings.Repeat("segment-", 500) + "example.com"
+	for _, bc := range []struct {
+		name  string
+		value string
+	}{
+		{"passing", "ok"},
+		{"failing", ""},
+	} {
+		b.Run(bc.name+"/all", func(b *testing.B) {
+			for b.Loop() {
+				_ = All(
+					Str(bc.value, "name").Required().V(),
+					Str(long, "site").Match(benchPattern).V(),
+				)
+			}
+		})
+		b.Run(bc.name+"/first", func(b *testing.B) {
+			for b.Loop() {
+				_ = First(
+					Str(bc.value, "name").Required().V(),
+					Str(long, "site").Match(benchPattern).V(),
+				)
+			}
+		})
+	}
+}
@@ -6,18 +6,34 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 )
 
 // FieldError represents a validation error for a specific field.
+// Code and Params describe the failure in machine-readable form; Message is the
+// human-readable description and is what Error reports.
 type FieldError struct {
 	Field   string
 	Message string
+	Code    string         // Validator name, e.g. "min" or "email"
+	Params  map[string]any // Validator parameters, e.g. {"min": 8}
+	key     string         // Message catalog key, when it differs from Code
+	custom  bool           // Message was overridden and is not localized
 }
 
 func (e *FieldError) Error() string {
 	return fmt.Sprintf("%s: %s", e.Field, e.Message)
 }
 
+// MessageKey returns the key used to look up the error's message in a [MessageCatalog].
+// It is the Code, qualified when a validator has more than one message, e.g. "min.string".
+func (e *FieldError) MessageKey() string {
+	if e.key != "" {
+		return e.key
+	}
+	return e.Code
+}
+
 // Errors is a collection of validation errors.
 type Errors []error
 
@@ -45,15 +61,50 @@ func (e Errors) Unwrap() []error {
 
 // Validation represents the result of a single validation check.
 // It tracks both the outcome (error or nil) and metadata about what was validated.
+// Validations from builders are evaluated when first inspected.
 type Validation struct {
 	err        error
 	field      string
 	validators []string
+	params     []map[string]any // Parameters of each validator, aligned with validators when set
+	nested     []*Validation    // Tracking for other fields, e.g. from Nested
+	lazy       *lazyValidation
+	group      *validationGroup
+}
+
+// lazyValidation holds the deferred evaluation of a Validation.
+type lazyValidation struct {
+	once sync.Once
+	eval func() *Validation
+}
+
+// deferred returns a Validation whose outcome is computed by eval when first inspected.
+func deferred(eval func() *Validation) *Validation {
+	return &Validation{lazy: &lazyValidation{eval: eval}}
+}
+
+// resolve runs a deferred evaluation, once.
+func (v *Validation) resolve() {
+	if v == nil || v.lazy == nil {
+		return
+	}
+	v.lazy.once.Do(func() {
+		r := v.lazy.eval()
+		if r == nil {
+			return
+		}
+		r.resolve()
+		v.err, v.field, v.validators, v.params, v.nested = r.err, r.field, r.validators, r.params, r.nested
+	})
 }
 
 // Error implements the error interface.
 func (v *Validation) Error() string {
-	if v == nil || v.err == nil {
+	if v == nil {
+		return ""
+	}
+	v.resolve()
+	if v.err == nil {
 		return ""
 	}
 	return v.err.Error()
@@ -61,15 +112,12 @@ func (v *Validation) Error() string {
 
 // Unwrap returns the underlying error for errors.Is/As compatibility.
 func (v *Validation) Unwrap() error {
-	if v == nil {
-		return nil
-	}
-	return v.err
+	return v.Err()
 }
 
 // Failed returns true if the validation failed.
 func (v *Validation) Failed() bool {
-	return v != nil && v.err != nil
+	return v.Err() != nil
 }
 
 // Err returns the validation error (nil if validation passed).
@@ -77,13 +125,31 @@ func (v *Validation) Err() error {
 	if v == nil {
 		return nil
 	}
+	v.resolve()
 	return v.err
 }
 
+// alignedParams returns the parameters of each of v's validators, nil for
+// validators without parameters.
+func (v *Validation) alignedParams() []map[string]any {
+	params := make([]map[string]any, len(v.validators))
+	copy(params, v.params)
+	return params
+}
+
+// AppliedValidator is a validator applied to a field, with the parameters it
+// was applied with, e.g. {Name: "min", Params: {"min": 8}}.
+type AppliedValidator struct {
+	Name   string
+	Params map[string]any // Nil for validators without parameters
+}
+
 // Result contains the aggregated outcome of multiple validations.
 type Result struct {
 	err     error
 	applied map[string][]string
+	params  map[string][]map[string]any // Parameters aligned with applied
+	fields  []string                    // Keys of applied in first-seen order
 }
 
 // Err returns the validation error (nil if validation passed).
@@ -111,6 +177,7 @@ func (r *Result) Unwrap() error {
 }
 
 // Applied returns a map of field names to validator names that were executed.
+// Validators are listed in the order they ran; use [Result.Fields] for field order.
 func (r *Result) Applied() map[string][]string {
 	if r == nil {
 		return nil
@@ -118,6 +185,26 @@ func (r *Result) Applied() map[string][]string {
 	return r.applied
 }
 
+// AppliedValidators returns the validators applied to a field with their
+// parameters, in the order they ran. Parameters are recorded whether or not
+// the validator passed, so a dry run describes the rules of a type; see
+// [SchemaFor].
+func (r *Result) AppliedValidators(field string) []AppliedValidator {
+	if r == nil || r.applied == nil {
+		return nil
+	}
+	names := r.applied[field]
+	params := r.params[field]
+	applied := make([]AppliedValidator, len(names))
+	for i, name := range names {
+		applied[i].Name = name
+		if i < len(params) {
+			applied[i].Params = params[i]
+		}
+	}
+	return applied
+}
+
 // HasValidator checks if a specific validator was applied to a field.
 func (r *Result) HasValidator(field, validator string) bool {
 	if r == nil || r.applied == nil {
@@ -143,20 +230,34 @@ func (r *Result) ValidatorsFor(field string) []string {
 	return r.applied[field]
 }
 
-// Fields returns all field names that had validators applied.
+// Fields returns all field names that had validators applied,
+// in the order they were first validated.
 func (r *Result) Fields() []string {
 	if r == nil || r.applied == nil {
 		return nil
 	}
-	fields := make([]string, 0, len(r.applied))
-	for field := range r.applied {
-		fields = append(fields, field)
-	}
+	order := r.fieldOrder()
+	fields := make([]string, len(order))
+	copy(fields, order)
 	return fields
 }
 
+// fieldOrder returns the keys of applied in first-seen order, or sorted
+// if the Result was built without order information.
+func (r *Result) fieldOrder() []string {
+	if len(r.fields) == len(r.applied) {
+		return r.fields
+	}
+	return sortedKeys(r.applied)
+}
+
 // validation creates a Validation result for a single validator.
+// A FieldError without a code takes the name of the first validator.
 func validation(err error, field string, validators ...string) *Validation {
+	var fe *FieldError
+	if errors.As(err, &fe) && fe.Code == "" && len(validators) > 0 {
+		fe.Code = validators[0]
+	}
 	return &Validation{
 		err:        err,
 		field:      field,
@@ -164,18 +265,84 @@ func validation(err error, field string, validators ...string) *Validation {
 	}
 }
 
+// with records a parameter of the validation's validators, and on its
+// FieldError if it failed.
+func (v *Validation) with(key string, value any) *Validation {
+	if len(v.params) == 0 && len(v.validators) > 0 {
+		shared := make(map[string]any)
+		v.params = make([]map[string]any, len(v.validators))
+		for i := range v.params {
+			v.params[i] = shared
+		}
+	}
+	if len(v.params) > 0 {
+		v.params[0][key] = value
+	}
+	var fe *FieldError
+	if errors.As(v.err, &fe) {
+		if fe.Params == nil {
+			fe.Params = make(map[string]any)
+		}
+		fe.Params[key] = value
+	}
+	return v
+}
+
+// tracker records applied validators per field in first-seen order.
+type tracker struct {
+	applied map[string][]string
+	params  map[string][]map[string]any
+	fields  []string
+}
+
+func newTracker() *tracker {
+	return &tracker{applied: make(map[string][]string), params: make(map[string][]map[string]any)}
+}
+
+// add records validators for a field, with their parameters if known.
+func (t *tracker) add(field string, validators []string, params []map[string]any) {
+	if _, ok := t.applied[field]; !ok {
+		t.fields = append(t.fields, field)
+	}
+	t.applied[field] = append(t.applied[field], validators...)
+	for i := range validators {
+		var p map[string]any
+		if i < len(params) {
+			p = params[i]
+		}
+		t.params[field] = append(t.params[field], p)
+	}
+}
+
+// track records the validators applied by v and its nested validations.
+func (t *tracker) track(v *Validation) {
+	v.resolve()
+	if v.field != "" || len(v.validators) > 0 {
+		t.add(v.field, v.validators, v.params)
+	}
+	for _, n := range v.nested {
+		t.track(n)
+	}
+}
+
+// result returns a Result with the tracked validators.
+func (t *tracker) result(err error) *Result {
+	return &Result{err: err, applied: t.applied, params: t.params, fields: t.fields}
+}
+
 // All collects all validations and returns a Result.
 // Tracks both successful and failed validations for metadata purposes.
+// Validations scoped with [Group] are skipped; see [AllIn].
 func All(validations ...*Validation) *Result {
-	applied := make(map[string][]string)
+	tracked := newTracker()
 	var errs []error
 
-	for _, v := range validations {
+	for _, v := range inGroups(validations, nil) {
 		if v == nil {
 			continue
 		}
 
-		applied[v.field] = append(applied[v.field], v.validators...)
+		tracked.track(v)
 
 		if v.err != nil {
 			errs = append(errs, v.err)
@@ -187,40 +354,42 @@ func All(validations ...*Validation) *Result {
 		err = Errors(errs)
 	}
 
-	return &Result{err: err, applied: applied}
+	return tracked.result(err)
 }
 
 // First returns a Result with the first failed validation, or nil error if all pass.
 // Still tracks all validations that were attempted up to and including the failure.
+// Builder validations after the failure are not evaluated.
+// Validations scoped with [Group] are skipped.
 func First(validations ...*Validation) *Result {
-	applied := make(map[string][]string)
+	tracked := newTracker()
 
-	for _, v := range validations {
+	for _, v := range inGroups(validations, nil) {
 		if v == nil {
 			continue
 		}
 
-		applied[v.field] = append(applied[v.field], v.validators...)
+		tracked.track(v)
 
 		if v.err != nil {
-			return &Result{err: v.err, applied: applied}
+			return tracked.result(v.err)
 		}
 	}
 
-	return &Result{err: nil, applied: applied}
+	return tracked.result(nil)
 }
 
 // Merge combines multiple Results into one.
 func Merge(results ...*Result) *Result {
-	applied := make(map[string][]string)
+	tracked := newTracker()
 	var errs []error
 
 	for _, r := range results {
 		if r == nil {
 			continue
 		}
-		for field, validators := range r.applied {
-			applied[field] = append(applied[field], validators...)
+		for _, field := range r.fieldOrder() {
+			tracked.add(field, r.applied[field], r.params[field])
 		}
 		if r.err != nil {
 			var nested Errors
@@ -236,7 +405,7 @@ func Merge(results ...*Result) *Result {
 	if len(errs) > 0 {
 		err = Errors(errs)
 	}
-	return &Result{err: err, applied: applied}
+	return tracked.result(err)
 }
 
 // HasErrors checks if a Result has any errors.
@@ -251,23 +420,26 @@ func GetFieldErrors(r *Result) []*FieldError {
 		return nil
 	}
 	var result []*FieldError
-
-	var errs Errors
-	if errors.As(r.err, &errs) {
-		for _, e := range errs {
-			var fe *FieldError
-			if errors.As(e, &fe) {
-				result = append(result, fe)
-			}
+	for _, e := range flatten(r.err) {
+		var fe *FieldError
+		if errors.As(e, &fe) {
+			result = append(result, fe)
 		}
-		return result
 	}
+	return result
+}
 
-	var fe *FieldError
-	if errors.As(r.err, &fe) {
-		result = append(result, fe)
+// flatten expands nested Errors into a single list.
+func flatten(err error) []error {
+	var errs Errors
+	if !errors.As(err, &errs) {
+		return []error{err}
 	}
-	return result
+	var flat []error
+	for _, e := range errs {
+		flat = append(flat, flatten(e)...)
+	}
+	return flat
 }
 
 // FieldNames returns all field names that have errors.
@@ -291,11 +463,80 @@ func HasField(r *Result, field string) bool {
 }
 
 // fieldErr creates a FieldError with the given field and message.
-func fieldErr(field, message string) error {
+func fieldErr(field, message string) *FieldError {
 	return &FieldError{Field: field, Message: message}
 }
 
 // fieldErrf creates a FieldError with a formatted message.
-func fieldErrf(field, format string, args ...any) error {
+func fieldErrf(field, format string, args ...any) *FieldError {
 	return &FieldError{Field: field, Message: fmt.Sprintf(format, args...)}
 }
+
+// rangeCode picks the code for a failed range check: upper when the value
+// exceeded the upper bound, lower otherwise.
+func rangeCode(exceeded bool, lower, upper string) string {
+	if exceeded {
+		return upper
+	}
+	return lower
+}
+
+// WithMessage returns a copy of v whose errors carry message instead of the
+// validator's default. The message may reference parameters, e.g. "at least {min}".
+// Tracking is preserved, and the message is kept as-is by [FieldError.Localize].
+func WithMessage(v *Validation, message string) *Validation {
+	return rewriteValidation(v, func(fe *FieldError) {
+		fe.Message = renderMessage(message, fe.Params)
+		fe.custom = true
+	})
+}
+
+// WithCode returns a copy of v whose errors carry code instead of the validator
+// name. Tracking and the message catalog key are preserved.
+func WithCode(v *Validation, code string) *Validation {
+	return rewriteValidation(v, func(fe *FieldError) {
+		fe.key = fe.MessageKey()
+		fe.Code = code
+	})
+}
+
+// rewriteValidation returns a copy of v with fn applied to copies of its FieldErrors.
+func rewriteValidation(v *Validation, fn func(*FieldError)) *Validation {
+	if v.Err() == nil {
+		return v
+	}
+	rewritten := Validation{field: v.field, validators: v.validators, params: v.params, nested: v.nested}
+	rewritten.err = rewriteFieldErrors(v.err, fn)
+	return &rewritten
+}
+
+// rewriteFieldErrors returns a copy of err with fn applied to copies of its FieldErrors.
+func rewriteFieldErrors(err error, fn func(*FieldError)) error {
+	switch e := err.(type) { //nolint:errorlint // rewriting concrete errors, not matching them
+	case Errors:
+		rewritten := make(Errors, len(e))
+		for i, inner := range e {
+			rewritten[i] = rewriteFieldErrors(inner, fn)
+		}
+		return rewritten
+	case *FieldError:
+		fe := *e
+		fn(&fe)
+		return &fe
+	}
+	return err
+}
+
+// withKey sets the message catalog key, for messages that differ from
+// the default message of the error's code.
+func (e *FieldError) withKey(key string) *FieldError {
+	e.key = key
+	return e
+}
+
+// withCode sets the error code, for validators whose failure is not
+// described by their first validator name (e.g. the upper bound of a range).
+func (e *FieldError) withCode(code string) *FieldError {
+	e.Code = code
+	return e
+}
@@ -2,7 +2,10 @@ package check
 
 import (
 	"errors"
+	"reflect"
+	"regexp"
 	"testing"
+	"time"
 )
 
 func TestFieldError(t *testing.T) {
@@ -363,6 +366,17 @@ func TestGetFieldErrors(t *testing.T) {
 			t.Errorf("expected 2, got %d", len(fes))
 		}
 	})
+
+	t.Run("from nested Errors", func(t *testing.T) {
+		r := All(
+			Str("", "email").Required().Email().V(),
+			Str("", "name").Required().V(),
+		)
+		fes := GetFieldErrors(r)
+		if len(fes) != 3 {
+			t.Errorf("expected 3, got %d", len(fes))
+		}
+	})
 }
 
 func TestFieldNames(t *testing.T) {
@@ -442,3 +456,256 @@ func TestValidationTracking_Integration(t *testing.T) {
 		}
 	})
 }
+
+func TestFieldErrorCodes(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		v       *Validation
+		code    string
+		params  map[string]any
+		message string
+	}{
+		{"Required", Required("", "f"), "required", nil, "is required"},
+		{"MinLen", MinLen("abc", 8, "f"), "min", map[string]any{"min": 8}, "must be at least 8 characters"},
+		{"LenBetween short", LenBetween("a", 2, 4, "f"), "min", map[string]any{"min": 2, "max": 4}, "must be between 2 and 4 characters"},
+		{"LenBetween long", LenBetween("abcde", 2, 4, "f"), "max", map[string]any{"min": 2, "max": 4}, "must be between 2 and 4 characters"},
+		{"OneOf", OneOf("x", []string{"a", "b"}, "f"), "oneof", map[string]any{"allowed": []string{"a", "b"}}, "must be one of: a, b"},
+		{"Email", Email("nope", "f"), "email", nil, "must be a valid email address"},
+		{"Between high", Between(11, 1, 10, "f"), "max", map[string]any{"min": 1, "max": 10}, "must be between 1 and 10"},
+		{"GreaterThan", GreaterThan(1, 5, "f"), "gt", map[string]any{"threshold": 5}, "must be greater than 5"},
+		{"MaxItems", MaxItems([]int{1, 2}, 1, "f"), "maxitems", map[string]any{"max": 1}, "must have at most 1 items"},
+		{"KeysBetween", KeysBetween(map[string]int{}, 1, 2, "f"), "minkeys", map[string]any{"min": 1, "max": 2}, "must have between 1 and 2 keys"},
+		{"HasKey", HasKey(map[string]int{}, "env", "f"), "haskey", map[string]any{"key": "env"}, "must contain key env"},
+		{"BetweenTime late", BetweenTime(end.Add(time.Hour), start, end, "f"), "before", map[string]any{"start": "2024-01-01T00:00:00Z", "end": "2024-12-31T00:00:00Z"}, "must be between 2024-01-01T00:00:00Z and 2024-12-31T00:00:00Z"},
+		{"DurationMin", DurationMin(time.Second, time.Minute, "f"), "min", map[string]any{"min": "1m0s"}, "must be at least 1m0s"},
+		{"EqualField", EqualField("a", "b", "f", "password"), "eqfield", map[string]any{"other": "password"}, "must equal password"},
+		{"RequiredPtr", RequiredPtr[string](nil, func(string) *Validation { return nil }, "f"), "required", nil, "is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fe *FieldError
+			if !errors.As(tt.v.Err(), &fe) {
+				t.Fatalf("expected FieldError, got %v", tt.v.Err())
+			}
+			if fe.Code != tt.code {
+				t.Errorf("expected code %q, got %q", tt.code, fe.Code)
+			}
+			if !reflect.DeepEqual(fe.Params, tt.params) {
+				t.Errorf("expected params %v, got %v", tt.params, fe.Params)
+			}
+			if fe.Message != tt.message {
+				t.Errorf("expected message %q, got %q", tt.message, fe.Message)
+			}
+		})
+	}
+
+	t.Run("passing validation has no error", func(t *testing.T) {
+		if MinLen("long enough", 3, "f").Err() != nil {
+			t.Error("expected nil error")
+		}
+	})
+}
+
+func TestResultFieldOrder(t *testing.T) {
+	equal := func(a, b []string) bool {
+		if len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if a[i] != b[i] {
+				return false
+			}
+		}
+		return true
+	}
+
+	t.Run("All preserves first-seen order", func(t *testing.T) {
+		for i := 0; i < 20; i++ {
+			r := All(
+				Str("x", "zeta").Required().V(),
+				Str("x", "alpha").Required().V(),
+				Str("x", "mid").Required().V(),
+				Str("x", "zeta").MaxLen(5).V(),
+			)
+			if got := r.Fields(); !equal(got, []string{"zeta", "alpha", "mid"}) {
+				t.Fatalf("unexpected order: %v", got)
+			}
+			if got := r.ValidatorsFor("zeta"); !equal(got, []string{"required", "max"}) {
+				t.Fatalf("unexpected validators: %v", got)
+			}
+		}
+	})
+
+	t.Run("First preserves order", func(t *testing.T) {
+		r := First(Required("x", "b"), Required("x", "a"), Required("", "c"), Required("", "d"))
+		if got := r.Fields(); !equal(got, []string{"b", "a", "c"}) {
+			t.Errorf("unexpected order: %v", got)
+		}
+	})
+
+	t.Run("Merge preserves order across results", func(t *testing.T) {
+		r := Merge(
+			All(Required("x", "b"), Required("x", "a")),
+			All(Required("x", "c"), Required("x", "b")),
+		)
+		if got := r.Fields(); !equal(got, []string{"b", "a", "c"}) {
+			t.Errorf("unexpected order: %v", got)
+		}
+	})
+
+	t.Run("results without order fall back to sorted", func(t *testing.T) {
+		r := &Result{applied: map[string][]string{"b": nil, "c": nil, "a": nil}}
+		if got := r.Fields(); !equal(got, []string{"a", "b", "c"}) {
+			t.Errorf("unexpected order: %v", got)
+		}
+	})
+
+	t.Run("Fields returns a copy", func(t *testing.T) {
+		r := All(Required("x", "a"), Required("x", "b"))
+		r.Fields()[0] = "changed"
+		if r.Fields()[0] != "a" {
+			t.Error("expected Fields to return a copy")
+		}
+	})
+
+	t.Run("nested fields keep child order", func(t *testing.T) {
+		child := All(Required("x", "zip"), Required("x", "city"), Required("x", "street"))
+		r := All(nestedResult(child, "address"))
+		if got := r.Fields(); !equal(got, []string{"address", "address.zip", "address.city", "address.street"}) {
+			t.Errorf("unexpected order: %v", got)
+		}
+	})
+}
+
+func TestWithMessage(t *testing.T) {
+	t.Run("replaces message and keeps tracking", func(t *testing.T) {
+		v := WithMessage(Match("x", regexp.MustCompile(`^[A-Z]{3}-\d{3}$`), "sku"), "must look like ABC-123")
+		if v.Error() != "sku: must look like ABC-123" {
+			t.Errorf("unexpected: %s", v.Error())
+		}
+		r := All(v)
+		if !r.HasValidator("sku", "pattern") {
+			t.Error("expected tracking to be preserved")
+		}
+		fe := GetFieldErrors(r)[0]
+		if fe.Code != "pattern" {
+			t.Errorf("expected code to be preserved, got %s", fe.Code)
+		}
+	})
+
+	t.Run("renders params", func(t *testing.T) {
+		v := WithMessage(MinLen("ab", 8, "password"), "needs {min}+ characters")
+		if v.Error() != "password: needs 8+ characters" {
+			t.Errorf("unexpected: %s", v.Error())
+		}
+	})
+
+	t.Run("not localized", func(t *testing.T) {
+		v := WithMessage(Required("", "name"), "please enter a name")
+		localized := All(v).Localize(Catalog{"required": "ist erforderlich"})
+		if localized.Error() != "name: please enter a name" {
+			t.Errorf("unexpected: %s", localized.Error())
+		}
+	})
+
+	t.Run("combined errors", func(t *testing.T) {
+		v := WithMessage(Str("", "email").Required().Email().V(), "enter an email")
+		if v.Error() != "email: enter an email; email: enter an email" {
+			t.Errorf("unexpected: %s", v.Error())
+		}
+	})
+
+	t.Run("does not modify original", func(t *testing.T) {
+		orig := Required("", "name")
+		WithMessage(orig, "changed")
+		if orig.Error() != "name: is required" {
+			t.Errorf("original modified: %s", orig.Error())
+		}
+	})
+
+	t.Run("passing and nil", func(t *testing.T) {
+		if v := WithMessage(Required("x", "name"), "changed"); v.Failed() || !All(v).HasValidator("name", "required") {
+			t.Error("expected passing validation to be unchanged")
+		}
+		if WithMessage(nil, "changed") != nil {
+			t.Error("expected nil")
+		}
+	})
+}
+
+func TestWithCode(t *testing.T) {
+	v := WithCode(Match("x", regexp.MustCompile(`^\d+$`), "sku"), "sku_format")
+	fe := GetFieldErrors(All(v))[0]
+	if fe.Code != "sku_format" {
+		t.Errorf("unexpected code: %s", fe.Code)
+	}
+	if fe.MessageKey() != "pattern" {
+		t.Errorf("expected message key to be preserved, got %s", fe.MessageKey())
+	}
+	if fe.Localize(English()) != fe.Message {
+		t.Errorf("expected default message to localize, got %s", fe.Localize(English()))
+	}
+	if !All(v).HasValidator("sku", "pattern") {
+		t.Error("expected tracking to be preserved")
+	}
+
+	variant := GetFieldErrors(All(WithCode(MinLen("a", 3, "f"), "too_short")))[0]
+	if variant.MessageKey() != "min.string" {
+		t.Errorf("expected variant key to be preserved, got %s", variant.MessageKey())
+	}
+}
+
+func TestAppliedValidators(t *testing.T) {
+	t.Run("records params of passing validators", func(t *testing.T) {
+		r := All(
+			Str("hello", "name").Required().LenBetween(2, 10).V(),
+			Positive(5, "count"),
+			Int(5, "total").Positive().NonNegative().V(),
+		)
+		want := []AppliedValidator{
+			{Name: "required"},
+			{Name: "min", Params: map[string]any{"min": 2, "max": 10}},
+			{Name: "max", Params: map[string]any{"min": 2, "max": 10}},
+		}
+		if got := r.AppliedValidators("name"); !reflect.DeepEqual(got, want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+		if got := r.AppliedValidators("count"); len(got) != 1 || got[0].Params["threshold"] != 0 {
+			t.Errorf("unexpected count validators: %v", got)
+		}
+		for _, a := range r.AppliedValidators("total") {
+			if a.Params["threshold"] != 0 {
+				t.Errorf("expected builder %s to record threshold 0, got %v", a.Name, a.Params)
+			}
+		}
+	})
+
+	t.Run("survives merge, nesting and pointers", func(t *testing.T) {
+		name := "Jo"
+		r := Merge(
+			All(Nested(nestedAddress{Street: "Main", Zip: "12345"}, "address")),
+			All(RequiredPtr(&name, func(v string) *Validation { return MaxLen(v, 5, "name") }, "name")),
+		)
+		if got := r.AppliedValidators("address.zip"); len(got) != 2 || got[1].Params["len"] != 5 {
+			t.Errorf("unexpected nested validators: %v", got)
+		}
+		want := []AppliedValidator{{Name: "required"}, {Name: "max", Params: map[string]any{"max": 5}}}
+		if got := r.AppliedValidators("name"); !reflect.DeepEqual(got, want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		if got := All().AppliedValidators("missing"); len(got) != 0 {
+			t.Errorf("expected none, got %v", got)
+		}
+		var r *Result
+		if r.AppliedValidators("x") != nil {
+			t.Error("expected nil for nil Result")
+		}
+	})
+}
@@ -1,15 +1,31 @@
 package check
 
 import (
+	"context"
 	"errors"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 
+	"github.com/zoobzio/check/internal/tagrules"
 	"github.com/zoobzio/sentinel"
 )
 
 // Check validates the given validations and verifies that all fields with validate tags were checked.
 // This is the primary API for struct validation - it combines validation execution with tag verification.
 //
+// A tagged field that was never validated produces an [UncheckedFieldError]. A field that was
+// validated, but not by every rule its tag declares, produces a [MissingRuleError] per missing rule.
+// The Opt builders and When record the validators they declare even when they skip an absent
+// value or a false condition, so optional fields are verified like any other.
+//
+// Verification recurses into nested structs using dotted and indexed paths such as
+// "address.zip" and "items[2].sku". Nested struct values are always verified; pointers
+// to structs, slices of structs and maps of structs are verified for each path that
+// appears in the applied validators, since their contents are only known at runtime.
+//
 // Usage:
 //
 //	func (r *Request) Validate() error {
@@ -19,10 +35,31 @@ import (
 //	    ).Err()
 //	}
 func Check[T any](validations ...*Validation) *Result {
-	result := All(validations...)
+	return verify[T](All(validations...), nil)
+}
 
-	// Inspect the type to get field metadata
+// CheckIn is like [Check] for the selected validation groups; see [Group] and [AllIn].
+// Coverage is verified against group-qualified tags: a field's validate_<group> tag,
+// for the first selected group that has one, replaces its validate tag.
+//
+//	ID string `json:"id" validate:"required,uuid" validate_create:"-"`
+func CheckIn[T any](groups []string, validations ...*Validation) *Result {
+	return verify[T](AllIn(groups, validations...), groups)
+}
+
+// CheckCtx is like [Check] for context validators, run in order with [AllCtx].
+func CheckCtx[T any](ctx context.Context, validators ...CtxValidator) *Result {
+	return verify[T](AllCtx(ctx, validators...), nil)
+}
+
+// verify adds an error to result for each tagged field or rule of T that was not validated,
+// reading tags for the given validation groups.
+func verify[T any](result *Result, groups []string) *Result {
+	// Inspect the type to get field metadata. Fields are read with structFields
+	// rather than from the metadata, which skips unexported embedded structs,
+	// so that embedded fields are promoted as encoding/json does.
 	metadata := sentinel.Inspect[T]()
+	owner := metadata.ReflectType
 
 	// Get the fields that were actually validated
 	applied := result.Applied()
@@ -30,28 +67,8 @@ func Check[T any](validations ...*Validation) *Result {
 		applied = make(map[string][]string)
 	}
 
-	// Check each field with a validate tag
-	var missingErrs []error
-	for _, field := range metadata.Fields {
-		validateTag, hasTag := field.Tags["validate"]
-		if !hasTag || validateTag == "" || validateTag == "-" {
-			continue
-		}
-
-		// Determine the field name to check (prefer json tag, fall back to field name)
-		fieldName := getFieldName(field)
-
-		// Check if this field was validated using either the json tag name or struct field name
-		_, validatedByFieldName := applied[fieldName]
-		_, validatedByStructName := applied[field.Name]
-		if !validatedByFieldName && !validatedByStructName {
-			missingErrs = append(missingErrs, &UncheckedFieldError{
-				Field:       fieldName,
-				StructField: field.Name,
-				Tag:         validateTag,
-			})
-		}
-	}
+	// Check each field with a validate tag, recursing into nested structs
+	missingErrs := verifyFields(owner, structFields(owner), fieldPath{}, applied, groups)
 
 	// If no missing validations, return original result
 	if len(missingErrs) == 0 {
@@ -73,28 +90,281 @@ func Check[T any](validations ...*Validation) *Result {
 	return &Result{
 		err:     allErrs,
 		applied: applied,
+		params:  result.params,
+		fields:  result.fields,
 	}
 }
 
-// getFieldName determines the field name used in validation.
-// Prefers json tag name, falls back to lowercase struct field name.
-func getFieldName(field sentinel.FieldMetadata) string {
-	if jsonTag, ok := field.Tags["json"]; ok && jsonTag != "" && jsonTag != "-" {
-		// Handle json tag options like `json:"name,omitempty"`
-		if idx := strings.Index(jsonTag, ","); idx != -1 {
-			jsonTag = jsonTag[:idx]
+// fieldPath is the location of a nested field during verification.
+type fieldPath struct {
+	candidates []string // Validation paths, preferring json tag names
+	goPath     string   // Path of Go struct field names
+}
+
+// child returns the path of a field below p, named by its json tag or struct field name.
+func (p fieldPath) child(field sentinel.FieldMetadata) fieldPath {
+	names := []string{getFieldName(field)}
+	if field.Name != names[0] {
+		names = append(names, field.Name)
+	}
+
+	prefixes := p.candidates
+	if len(prefixes) == 0 {
+		prefixes = []string{""}
+	}
+
+	candidates := make([]string, 0, len(prefixes)*len(names))
+	for _, prefix := range prefixes {
+		for _, name := range names {
+			candidates = append(candidates, joinPath(prefix, name))
 		}
-		if jsonTag != "" {
-			return jsonTag
+	}
+	return fieldPath{candidates: candidates, goPath: joinPath(p.goPath, field.Name)}
+}
+
+// elements returns the paths of elements below p that appear in applied, e.g. "items[2]".
+func (p fieldPath) elements(applied map[string][]string) []fieldPath {
+	seen := make(map[string]bool)
+	var elems []fieldPath
+	for key := range applied {
+		for _, candidate := range p.candidates {
+			rest, ok := strings.CutPrefix(key, candidate+"[")
+			if !ok {
+				continue
+			}
+			idx := strings.Index(rest, "]")
+			if idx == -1 {
+				continue
+			}
+			index := rest[:idx+1]
+			if seen[index] {
+				continue
+			}
+			seen[index] = true
+			elems = append(elems, p.element("["+index))
+		}
+	}
+	sort.Slice(elems, func(i, j int) bool { return compareIndexes(elems[i].goPath, elems[j].goPath) < 0 })
+	return elems
+}
+
+// compareIndexes orders element paths by their final index,
+// comparing numerically so "items[2]" sorts before "items[10]".
+func compareIndexes(a, b string) int {
+	a, b = a[strings.LastIndex(a, "[")+1:], b[strings.LastIndex(b, "[")+1:]
+	ai, aErr := strconv.Atoi(strings.TrimSuffix(a, "]"))
+	bi, bErr := strconv.Atoi(strings.TrimSuffix(b, "]"))
+	if aErr == nil && bErr == nil {
+		return ai - bi
+	}
+	return strings.Compare(a, b)
+}
+
+// element returns the path of the element with the given index suffix, e.g. "[2]".
+func (p fieldPath) element(index string) fieldPath {
+	candidates := make([]string, len(p.candidates))
+	for i, candidate := range p.candidates {
+		candidates[i] = candidate + index
+	}
+	return fieldPath{candidates: candidates, goPath: p.goPath + index}
+}
+
+// appliedBelow reports whether any applied field is nested below p.
+func (p fieldPath) appliedBelow(applied map[string][]string) bool {
+	for key := range applied {
+		for _, candidate := range p.candidates {
+			if strings.HasPrefix(key, candidate+".") {
+				return true
+			}
 		}
 	}
-	return strings.ToLower(field.Name)
+	return false
+}
+
+// verifyFields checks each tagged field of owner against the applied validators and
+// recurses into nested struct types.
+func verifyFields(owner reflect.Type, fields []sentinel.FieldMetadata, parent fieldPath, applied map[string][]string, groups []string) []error {
+	var errs []error
+	for _, field := range fields {
+		validateTag := groupTag(owner, field, groups)
+		if validateTag == "-" {
+			continue
+		}
+		path := parent.child(field)
+
+		if validateTag != "" {
+			errs = append(errs, verifyField(path, validateTag, applied)...)
+		}
+
+		errs = append(errs, verifyNested(field.ReflectType, path, applied, groups)...)
+	}
+	return errs
+}
+
+// groupTag returns the validate tag of a field of owner for the given groups:
+// the validate_<group> tag of the first group that has one, or the validate tag.
+func groupTag(owner reflect.Type, field sentinel.FieldMetadata, groups []string) string {
+	if len(groups) > 0 && owner != nil && owner.Kind() == reflect.Struct {
+		return tagrules.GroupTag(owner.FieldByIndex(field.Index).Tag, groups)
+	}
+	return field.Tags["validate"]
+}
+
+// verifyField checks a single tagged field against the applied validators.
+func verifyField(path fieldPath, validateTag string, applied map[string][]string) []error {
+	// Check if this field was validated under any of its candidate paths
+	var validators []string
+	validated := false
+	for _, candidate := range path.candidates {
+		if v, ok := applied[candidate]; ok {
+			validated = true
+			validators = append(validators, v...)
+		}
+	}
+
+	fieldName := path.candidates[0]
+	structField := path.goPath
+
+	if !validated {
+		return []error{&UncheckedFieldError{
+			Field:       fieldName,
+			StructField: structField,
+			Tag:         validateTag,
+		}}
+	}
+
+	// Check that each declared rule was backed by an applied validator
+	var errs []error
+	for _, rule := range tagrules.FieldRules(validateTag) {
+		if !ruleSatisfied(rule, validators) {
+			errs = append(errs, &MissingRuleError{
+				Field:       fieldName,
+				StructField: structField,
+				Rule:        rule.String(),
+				Tag:         validateTag,
+			})
+		}
+	}
+	return errs
+}
+
+// verifyNested recurses into struct values, pointers to structs, and
+// slices, arrays and maps of structs.
+func verifyNested(t reflect.Type, path fieldPath, applied map[string][]string, groups []string) []error {
+	if t == nil {
+		return nil
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		return verifyFields(t, structFields(t), path, applied, groups)
+	case reflect.Ptr:
+		if elem := t.Elem(); elem.Kind() == reflect.Struct && path.appliedBelow(applied) {
+			return verifyFields(elem, structFields(elem), path, applied, groups)
+		}
+	case reflect.Slice, reflect.Array, reflect.Map:
+		elem := t.Elem()
+		if elem.Kind() == reflect.Ptr {
+			elem = elem.Elem()
+		}
+		if elem.Kind() != reflect.Struct {
+			return nil
+		}
+		fields := structFields(elem)
+		var errs []error
+		for _, elemPath := range path.elements(applied) {
+			errs = append(errs, verifyFields(elem, fields, elemPath, applied, groups)...)
+		}
+		return errs
+	}
+	return nil
+}
+
+// structFields returns field metadata for a nested struct type.
+// Nested types are read directly since sentinel only inspects type parameters.
+// Fields of embedded structs without a json name are promoted as encoding/json
+// promotes them, unless a shallower field has the same name.
+func structFields(t reflect.Type) []sentinel.FieldMetadata {
+	return promotedFields(t, nil, nil)
+}
+
+// promotedFields returns the fields of t, indexed from the enclosing struct by
+// index, and those of its embedded structs. enclosing guards against embedded
+// pointers to an enclosing type.
+func promotedFields(t reflect.Type, index []int, enclosing []reflect.Type) []sentinel.FieldMetadata {
+	fields := make([]sentinel.FieldMetadata, 0, t.NumField())
+	var embedded []reflect.StructField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.Anonymous && tagrules.JSONName(sf.Tag.Get("json")) == "" && sf.Tag.Get("json") != "-" {
+			if et := derefType(sf.Type); et.Kind() == reflect.Struct {
+				embedded = append(embedded, sf)
+				continue
+			}
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		tags := make(map[string]string)
+		for _, name := range []string{"json", "validate"} {
+			if v := sf.Tag.Get(name); v != "" {
+				tags[name] = v
+			}
+		}
+		fields = append(fields, sentinel.FieldMetadata{
+			Index:       append(slices.Clone(index), sf.Index...),
+			Name:        sf.Name,
+			Type:        sf.Type.String(),
+			ReflectType: sf.Type,
+			Tags:        tags,
+		})
+	}
+
+	for _, sf := range embedded {
+		et := derefType(sf.Type)
+		if slices.Contains(enclosing, et) {
+			continue
+		}
+		for _, field := range promotedFields(et, append(slices.Clone(index), sf.Index...), append(enclosing, t)) {
+			shadowed := slices.ContainsFunc(fields, func(f sentinel.FieldMetadata) bool {
+				return getFieldName(f) == getFieldName(field)
+			})
+			if !shadowed {
+				fields = append(fields, field)
+			}
+		}
+	}
+	return fields
+}
+
+// derefType returns the type t points to, or t if it is not a pointer.
+func derefType(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Ptr {
+		return t.Elem()
+	}
+	return t
+}
+
+// joinPath appends a field name to a path, using "." unless the name is an index.
+func joinPath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	if strings.HasPrefix(name, "[") {
+		return prefix + name
+	}
+	return prefix + "." + name
+}
+
+// getFieldName determines the field name used in validation.
+// Prefers json tag name, falls back to lowercase struct field name.
+func getFieldName(field sentinel.FieldMetadata) string {
+	return tagrules.FieldName(field.Name, field.Tags["json"])
 }
 
 // UncheckedFieldError indicates a field has validation requirements but was not validated.
 type UncheckedFieldError struct {
 	Field       string // The field name used in validation (json tag or lowercase)
-	StructField string // The actual struct field name
+	StructField string // The actual struct field name, dotted for nested fields
 	Tag         string // The validate tag value
 }
 
@@ -102,3 +372,14 @@ func (e *UncheckedFieldError) Error() string {
 	return e.Field + ": tagged but not validated (validate: " + e.Tag + ")"
 }
 
+// MissingRuleError indicates a field was validated, but not by a rule its validate tag declares.
+type MissingRuleError struct {
+	Field       string // The field name used in validation (json tag or lowercase)
+	StructField string // The actual struct field name, dotted for nested fields
+	Rule        string // The tag rule that was not applied, e.g. "email" or "min=8"
+	Tag         string // The validate tag value
+}
+
+func (e *MissingRuleError) Error() string {
+	return e.Field + ": rule " + e.Rule + " not validated (validate: " + e.Tag + ")"
+}
@@ -2,6 +2,8 @@ package check
 
 import (
 	"errors"
+	"fmt"
+	"slices"
 	"testing"
 )
 
@@ -167,3 +169,362 @@ func TestUncheckedFieldError(t *testing.T) {
 	}
 }
 
+func TestCheckedRules(t *testing.T) {
+	t.Run("missing tag rule - fails", func(t *testing.T) {
+		name := "John"
+		// email is tagged required,email but only MaxLen is applied
+		result := Check[CheckedRequest](
+			Str("test@example.com", "email").MaxLen(255).V(),
+			Str("password123", "password").Required().MinLen(8).V(),
+			OptStr(&name, "name").MaxLen(100).V(),
+		)
+
+		var errs Errors
+		if !errors.As(result.Err(), &errs) {
+			t.Fatalf("expected Errors type, got %T", result.Err())
+		}
+
+		var rules []string
+		for _, err := range errs {
+			var mr *MissingRuleError
+			if errors.As(err, &mr) {
+				if mr.Field != "email" {
+					t.Errorf("expected field 'email', got %q", mr.Field)
+				}
+				rules = append(rules, mr.Rule)
+			}
+		}
+		if len(rules) != 2 || rules[0] != "required" || rules[1] != "email" {
+			t.Errorf("expected missing rules [required email], got %v", rules)
+		}
+	})
+
+	t.Run("missing rule with parameter", func(t *testing.T) {
+		name := "John"
+		result := Check[CheckedRequest](
+			Str("test@example.com", "email").Required().Email().V(),
+			Str("password123", "password").Required().V(),
+			OptStr(&name, "name").MaxLen(100).V(),
+		)
+
+		var mr *MissingRuleError
+		if !errors.As(result.Err(), &mr) {
+			t.Fatalf("expected MissingRuleError, got: %v", result.Err())
+		}
+		if mr.Field != "password" || mr.Rule != "min=8" {
+			t.Errorf("unexpected missing rule: %+v", mr)
+		}
+	})
+
+	t.Run("unchecked field does not also report rules", func(t *testing.T) {
+		result := Check[PartiallyValidated](
+			Str("x", "required").Required().V(),
+		)
+
+		var errs Errors
+		if !errors.As(result.Err(), &errs) {
+			t.Fatalf("expected Errors type, got %T", result.Err())
+		}
+		for _, err := range errs {
+			var mr *MissingRuleError
+			if errors.As(err, &mr) {
+				t.Errorf("unexpected MissingRuleError: %v", mr)
+			}
+		}
+	})
+
+	t.Run("rules satisfied via aliases", func(t *testing.T) {
+		type Aliased struct {
+			Tags  []string `json:"tags" validate:"min=1,max=5"`
+			ID    string   `json:"id" validate:"uuid"`
+			Color string   `json:"color" validate:"hexcolor|rgb"`
+		}
+		result := Check[Aliased](
+			StrSlice([]string{"a"}, "tags").MinItems(1).MaxItems(5).V(),
+			Str("550e8400-e29b-41d4-a716-446655440000", "id").UUID4().V(),
+			Str("#fff", "color").HexColor().V(),
+		)
+		if result.Err() != nil {
+			t.Errorf("expected pass, got: %v", result.Err())
+		}
+	})
+
+	t.Run("skipped optional steps are still recorded", func(t *testing.T) {
+		type Optional struct {
+			Nickname *string `json:"nickname" validate:"min=2"`
+			Score    float64 `json:"score" validate:"omitempty,gt=0"`
+			Website  *string `json:"website" validate:"required,url"`
+		}
+		result := Check[Optional](
+			OptStr(nil, "nickname").MinLen(2).V(),
+			Num(0.0, "score").When(false, func(b *NumBuilder[float64]) { b.GreaterThan(0) }).V(),
+		)
+		var ue *UncheckedFieldError
+		if !errors.As(result.Err(), &ue) || ue.Field != "website" {
+			t.Fatalf("expected only website to be unchecked, got: %v", result.Err())
+		}
+		var errs Errors
+		if errors.As(result.Err(), &errs) && len(errs) != 1 {
+			t.Errorf("expected a single error, got: %v", errs)
+		}
+
+		nickname := "x"
+		result = Check[Optional](
+			OptStr(&nickname, "nickname").MaxLen(5).V(),
+			RequiredPtr(Ptr("https://example.com"), func(v string) *Validation { return URL(v, "website") }, "website"),
+		)
+		var mr *MissingRuleError
+		if !errors.As(result.Err(), &mr) || mr.Field != "nickname" || mr.Rule != "min=2" {
+			t.Errorf("expected rules of a validated optional field to be verified, got: %v", result.Err())
+		}
+	})
+
+	t.Run("notblank satisfied by NotBlank", func(t *testing.T) {
+		type Named struct {
+			Name string `json:"name" validate:"notblank"`
+		}
+		result := Check[Named](
+			Str("John", "name").NotBlank().V(),
+		)
+		if result.Err() != nil {
+			t.Errorf("expected pass, got: %v", result.Err())
+		}
+	})
+
+	t.Run("rules after dive are not required on the field", func(t *testing.T) {
+		type Dived struct {
+			Tags []string `json:"tags" validate:"required,dive,email"`
+		}
+		result := Check[Dived](
+			StrSlice(nil, "tags").NotEmpty().V(),
+		)
+		var mr *MissingRuleError
+		if errors.As(result.Err(), &mr) {
+			t.Errorf("unexpected MissingRuleError: %v", mr)
+		}
+	})
+}
+
+func TestMissingRuleError(t *testing.T) {
+	err := &MissingRuleError{
+		Field:       "email",
+		StructField: "Email",
+		Rule:        "email",
+		Tag:         "required,email",
+	}
+
+	expected := "email: rule email not validated (validate: required,email)"
+	if err.Error() != expected {
+		t.Errorf("unexpected error message:\ngot:  %s\nwant: %s", err.Error(), expected)
+	}
+}
+
+type NestedAddress struct {
+	Street string `json:"street" validate:"required"`
+	Zip    string `json:"zip" validate:"required,len=5"`
+}
+
+type NestedLineItem struct {
+	SKU string `json:"sku" validate:"required"`
+	Qty int    `json:"qty" validate:"min=1"`
+}
+
+type NestedRequest struct {
+	Name     string                    `json:"name" validate:"required"`
+	Address  NestedAddress             `json:"address"`
+	Billing  *NestedAddress            `json:"billing"`
+	Items    []NestedLineItem          `json:"items" validate:"required"`
+	Extras   map[string]NestedLineItem `json:"extras"`
+	Internal NestedAddress             `json:"-" validate:"-"`
+}
+
+func TestCheckedNested(t *testing.T) {
+	base := func() []*Validation {
+		return []*Validation{
+			Str("Jane", "name").Required().V(),
+			Str("Main St", "address.street").Required().V(),
+			Str("12345", "address.zip").Required().Len(5).V(),
+			Slice([]NestedLineItem{{}}, "items").NotEmpty().V(),
+		}
+	}
+
+	uncheckedFields := func(t *testing.T, r *Result) []string {
+		t.Helper()
+		var fields []string
+		var errs Errors
+		if !errors.As(r.Err(), &errs) {
+			return nil
+		}
+		for _, err := range errs {
+			var ue *UncheckedFieldError
+			if errors.As(err, &ue) {
+				fields = append(fields, ue.Field)
+			}
+		}
+		return fields
+	}
+
+	t.Run("nested struct fields validated - passes", func(t *testing.T) {
+		result := Check[NestedRequest](base()...)
+		if result.Err() != nil {
+			t.Errorf("expected pass, got: %v", result.Err())
+		}
+	})
+
+	t.Run("nested struct field not validated - fails", func(t *testing.T) {
+		result := Check[NestedRequest](
+			Str("Jane", "name").Required().V(),
+			Str("Main St", "address.street").Required().V(),
+			Slice([]NestedLineItem{{}}, "items").NotEmpty().V(),
+		)
+		fields := uncheckedFields(t, result)
+		if len(fields) != 1 || fields[0] != "address.zip" {
+			t.Errorf("expected unchecked [address.zip], got %v (%v)", fields, result.Err())
+		}
+
+		var ue *UncheckedFieldError
+		if errors.As(result.Err(), &ue) && ue.StructField != "Address.Zip" {
+			t.Errorf("expected struct field Address.Zip, got %q", ue.StructField)
+		}
+	})
+
+	t.Run("nested rule missing - fails", func(t *testing.T) {
+		result := Check[NestedRequest](
+			Str("Jane", "name").Required().V(),
+			Str("Main St", "address.street").Required().V(),
+			Str("12345", "address.zip").Required().V(),
+			Slice([]NestedLineItem{{}}, "items").NotEmpty().V(),
+		)
+		var mr *MissingRuleError
+		if !errors.As(result.Err(), &mr) {
+			t.Fatalf("expected MissingRuleError, got: %v", result.Err())
+		}
+		if mr.Field != "address.zip" || mr.Rule != "len=5" {
+			t.Errorf("unexpected missing rule: %+v", mr)
+		}
+	})
+
+	t.Run("nil pointer struct is not verified", func(t *testing.T) {
+		result := Check[NestedRequest](base()...)
+		for _, f := range uncheckedFields(t, result) {
+			if f == "billing.street" || f == "billing.zip" {
+				t.Errorf("unexpected unchecked field %s", f)
+			}
+		}
+	})
+
+	t.Run("pointer struct verified when validated", func(t *testing.T) {
+		validations := append(base(), Str("Side St", "billing.street").Required().V())
+		fields := uncheckedFields(t, Check[NestedRequest](validations...))
+		if len(fields) != 1 || fields[0] != "billing.zip" {
+			t.Errorf("expected unchecked [billing.zip], got %v", fields)
+		}
+	})
+
+	t.Run("slice elements verified by index", func(t *testing.T) {
+		validations := append(base(),
+			Str("A-1", "items[0].sku").Required().V(),
+			Num(1, "items[0].qty").Min(1).V(),
+			Str("B-2", "items[1].sku").Required().V(),
+		)
+		fields := uncheckedFields(t, Check[NestedRequest](validations...))
+		if len(fields) != 1 || fields[0] != "items[1].qty" {
+			t.Errorf("expected unchecked [items[1].qty], got %v", fields)
+		}
+	})
+
+	t.Run("map values verified by key", func(t *testing.T) {
+		validations := append(base(),
+			Str("C-3", "extras[gift].sku").Required().V(),
+		)
+		fields := uncheckedFields(t, Check[NestedRequest](validations...))
+		if len(fields) != 1 || fields[0] != "extras[gift].qty" {
+			t.Errorf("expected unchecked [extras[gift].qty], got %v", fields)
+		}
+	})
+
+	t.Run("elements ordered by numeric index", func(t *testing.T) {
+		var validations []*Validation
+		for _, i := range []int{10, 2, 1} {
+			validations = append(validations, Str("A-1", fmt.Sprintf("items[%d].sku", i)).Required().V())
+		}
+		fields := uncheckedFields(t, Check[NestedRequest](append(base(), validations...)...))
+		want := []string{"items[1].qty", "items[2].qty", "items[10].qty"}
+		if !slices.Equal(fields, want) {
+			t.Errorf("expected unchecked %v, got %v", want, fields)
+		}
+	})
+
+	t.Run("struct field names accepted at every level", func(t *testing.T) {
+		result := Check[NestedRequest](
+			Str("Jane", "Name").Required().V(),
+			Str("Main St", "Address.Street").Required().V(),
+			Str("12345", "address.Zip").Required().Len(5).V(),
+			Slice([]NestedLineItem{{}}, "items").NotEmpty().V(),
+		)
+		if result.Err() != nil {
+			t.Errorf("expected pass, got: %v", result.Err())
+		}
+	})
+}
+
+type nestedAudit struct {
+	CreatedBy string `json:"created_by" validate:"required"`
+}
+
+type NestedTimestamps struct {
+	Note string `json:"note" validate:"required"`
+}
+
+type NestedEmbedding struct {
+	nestedAudit
+	*NestedTimestamps
+	Address NestedAddress `json:"address"`
+	Owner   nestedAudit   `json:"owner"`
+	Note    string        `json:"note" validate:"max=10"`
+}
+
+func TestCheckedEmbedded(t *testing.T) {
+	t.Run("embedded fields promoted", func(t *testing.T) {
+		result := Check[NestedEmbedding](
+			Str("jane", "created_by").Required().V(),
+			Str("hi", "note").MaxLen(10).V(),
+			Str("Main St", "address.street").Required().V(),
+			Str("12345", "address.zip").Required().Len(5).V(),
+			Str("joe", "owner.created_by").Required().V(),
+		)
+		if result.Err() != nil {
+			t.Errorf("expected pass, got: %v", result.Err())
+		}
+	})
+
+	t.Run("promoted field not validated - fails", func(t *testing.T) {
+		result := Check[NestedEmbedding](
+			Str("hi", "note").MaxLen(10).V(),
+			Str("Main St", "address.street").Required().V(),
+			Str("12345", "address.zip").Required().Len(5).V(),
+			Str("joe", "owner.created_by").Required().V(),
+		)
+		var ue *UncheckedFieldError
+		if !errors.As(result.Err(), &ue) {
+			t.Fatalf("expected UncheckedFieldError, got: %v", result.Err())
+		}
+		if ue.Field != "created_by" || ue.StructField != "CreatedBy" {
+			t.Errorf("unexpected unchecked field: %+v", ue)
+		}
+	})
+}
+
+func TestJoinPath(t *testing.T) {
+	tests := []struct{ prefix, name, want string }{
+		{"", "zip", "zip"},
+		{"address", "zip", "address.zip"},
+		{"items", "[2]", "items[2]"},
+		{"items[2]", "sku", "items[2].sku"},
+	}
+	for _, tt := range tests {
+		if got := joinPath(tt.prefix, tt.name); got != tt.want {
+			t.Errorf("joinPath(%q, %q) = %q, want %q", tt.prefix, tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,554 @@
+// Package checkvet defines an analyzer that checks calls to check.Check[T]
+// against the validate tags of T.
+//
+// Check[T] verifies coverage at runtime, and only on the code paths a test
+// exercises. The analyzer reads the validations passed to Check[T] and
+// CheckIn[T] statically, resolving the field names given to builders such as
+// Str and Num and to functions such as Required and EqualField, and reports:
+//
+//   - tagged fields of T that no validation names
+//   - field names that match no field of T, by json tag or lowercased name
+//   - tag rules that no builder method or function applied to the field satisfies
+//
+// A Check[T] whose validations come from helpers the analyzer cannot see into,
+// or whose field names are not constants, is not reported as missing fields or
+// rules, since they may be validated out of sight.
+//
+// Run it with the checkvet command, standalone or as go vet -vettool.
+package checkvet
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/zoobzio/check/internal/tagrules"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// checkPath is the import path of the check package.
+const checkPath = "github.com/zoobzio/check"
+
+// Analyzer reports validate tags that calls to check.Check[T] do not cover.
+var Analyzer = &analysis.Analyzer{
+	Name:     "checkvet",
+	Doc:      "check that Check[T] validations cover the validate tags of T",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	insp, ok := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	if !ok {
+		return nil, nil
+	}
+	insp.Preorder([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node) {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return
+		}
+		target, groups, args, ok := checkCall(pass, call)
+		if !ok {
+			return
+		}
+		c := &collector{pass: pass, target: target, groups: groups, uses: make(map[string]*fieldUse)}
+		for _, arg := range args {
+			c.validation(arg)
+		}
+		c.report(call)
+	})
+	return nil, nil
+}
+
+// checkCall reports whether call is check.Check[T] or check.CheckIn[T],
+// returning T, the groups passed to CheckIn and the validations passed. A
+// CheckIn whose groups are not constant is not reported, since the tags it
+// verifies are unknown.
+func checkCall(pass *analysis.Pass, call *ast.CallExpr) (*types.Named, []string, []ast.Expr, bool) {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || !fromCheck(fn) || (fn.Name() != "Check" && fn.Name() != "CheckIn") || fn.Signature().Recv() != nil {
+		return nil, nil, nil, false
+	}
+	var id *ast.Ident
+	switch fun := ast.Unparen(call.Fun).(type) {
+	case *ast.IndexExpr:
+		id = calleeIdent(fun.X)
+	case *ast.IndexListExpr:
+		id = calleeIdent(fun.X)
+	}
+	inst, ok := pass.TypesInfo.Instances[id]
+	if id == nil || !ok || inst.TypeArgs.Len() != 1 {
+		return nil, nil, nil, false
+	}
+	target, ok := types.Unalias(inst.TypeArgs.At(0)).(*types.Named)
+	if !ok {
+		return nil, nil, nil, false
+	}
+	if _, ok := target.Underlying().(*types.Struct); !ok {
+		return nil, nil, nil, false
+	}
+	args := call.Args
+	var groups []string
+	if fn.Name() == "CheckIn" && len(args) > 0 {
+		if groups, ok = constantStrings(pass, args[0]); !ok {
+			return nil, nil, nil, false
+		}
+		args = args[1:]
+	}
+	return target, groups, args, true
+}
+
+// constantStrings returns the values of a string slice literal of constants,
+// such as []string{"create"}.
+func constantStrings(pass *analysis.Pass, e ast.Expr) ([]string, bool) {
+	if id, ok := ast.Unparen(e).(*ast.Ident); ok && id.Name == "nil" {
+		return nil, true
+	}
+	lit, ok := ast.Unparen(e).(*ast.CompositeLit)
+	if !ok {
+		return nil, false
+	}
+	values := make([]string, 0, len(lit.Elts))
+	for _, elt := range lit.Elts {
+		tv, ok := pass.TypesInfo.Types[elt]
+		if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+			return nil, false
+		}
+		values = append(values, constant.StringVal(tv.Value))
+	}
+	return values, true
+}
+
+// calleeIdent returns the identifier naming a called function, e.g. Check in check.Check.
+func calleeIdent(e ast.Expr) *ast.Ident {
+	switch e := ast.Unparen(e).(type) {
+	case *ast.Ident:
+		return e
+	case *ast.SelectorExpr:
+		return e.Sel
+	}
+	return nil
+}
+
+// fieldUse records how a field is validated within one Check[T] call.
+type fieldUse struct {
+	pos    token.Pos       // First reference
+	calls  map[string]bool // Names of check functions and builder methods applied
+	named  map[string]bool // Names of builder Check predicates applied
+	opaque bool            // Validated in part by code the analyzer cannot see into
+}
+
+// collector gathers the fields validated by the arguments of a Check[T] call.
+type collector struct {
+	pass       *analysis.Pass
+	target     *types.Named
+	groups     []string             // Groups passed to CheckIn
+	uses       map[string]*fieldUse // By field path, without indexes
+	incomplete bool                 // Some validation names its fields out of sight
+	closures   int                  // Depth of function literals being walked
+}
+
+// expression accumulates what a single validation applies, before it is
+// attributed to the fields it names.
+type expression struct {
+	names     []string
+	positions []token.Pos
+	calls     map[string]bool
+	named     map[string]bool
+	opaque    bool
+	nested    bool // Holds validations of its own, as Group does
+}
+
+// validation records a validation expression passed to Check[T], or to a
+// function taking validations such as Group or AnyOf.
+func (c *collector) validation(e ast.Expr) {
+	x := &expression{calls: make(map[string]bool), named: make(map[string]bool)}
+	c.walk(e, x)
+	if len(x.names) == 0 && !x.nested {
+		c.incomplete = true
+		return
+	}
+	for i, path := range x.names {
+		use, ok := c.uses[path]
+		if !ok {
+			use = &fieldUse{pos: x.positions[i], calls: make(map[string]bool), named: make(map[string]bool)}
+			c.uses[path] = use
+		}
+		for call := range x.calls {
+			use.calls[call] = true
+		}
+		for name := range x.named {
+			use.named[name] = true
+		}
+		use.opaque = use.opaque || x.opaque
+	}
+}
+
+// walk records the check functions, methods and field names in n.
+func (c *collector) walk(n ast.Node, x *expression) {
+	ast.Inspect(n, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.Ident:
+			if fn, ok := c.pass.TypesInfo.Uses[n].(*types.Func); ok && fromCheck(fn) {
+				x.calls[fn.Name()] = true
+			}
+		case *ast.CallExpr:
+			c.call(n, x)
+			return false
+		}
+		return true
+	})
+}
+
+// call records a call within a validation expression.
+func (c *collector) call(call *ast.CallExpr, x *expression) {
+	fn, ok := typeutil.Callee(c.pass.TypesInfo, call).(*types.Func)
+	if !ok || !fromCheck(fn) {
+		if isCheckType(c.pass.TypesInfo.TypeOf(call), "Validation") {
+			x.opaque = true
+		}
+		c.walkAll(call.Fun, call.Args, x)
+		return
+	}
+	x.calls[fn.Name()] = true
+	c.walk(call.Fun, x)
+
+	sig := fn.Signature()
+	for i, arg := range call.Args {
+		param := paramAt(sig, i)
+		switch {
+		case isCheckType(param.Type(), "Validation"):
+			x.nested = true
+			c.validation(arg)
+		case param.Name() == "field":
+			c.field(arg, x)
+		case param.Name() == "otherField":
+			c.name(arg)
+		case fieldGroups[fn.Name()] && fn.Signature().Recv() == nil:
+			c.groupRef(arg, x)
+		case fn.Name() == "Ref" && param.Name() == "name":
+			c.name(arg)
+		case fn.Name() == "Check" && param.Name() == "name":
+			if name, ok := c.constant(arg); ok {
+				x.named[name] = true
+			}
+		case isSignature(param.Type()):
+			c.funcArg(fn, arg, x)
+		default:
+			c.walk(arg, x)
+		}
+	}
+}
+
+// funcArg records a function passed to a check function or method.
+func (c *collector) funcArg(fn *types.Func, arg ast.Expr, x *expression) {
+	switch lit, isLit := ast.Unparen(arg).(*ast.FuncLit); {
+	case strings.HasPrefix(fn.Name(), "Each") || fn.Name() == "Check":
+		// Element rules and named predicates do not apply check functions
+		// to the field itself.
+	case isBuilderFunc(c.pass.TypesInfo.TypeOf(arg)):
+		// When, Either and Not closures apply builder methods to the field.
+		c.walk(arg, x)
+	case isLit:
+		// Closures such as those passed to NilOr and Custom may name the
+		// field themselves, or receive it as a parameter.
+		c.closures++
+		c.walk(lit.Body, x)
+		c.closures--
+	default:
+		if fn, ok := c.pass.TypesInfo.Uses[calleeIdent(arg)].(*types.Func); ok && fromCheck(fn) {
+			x.calls[fn.Name()] = true
+			return
+		}
+		x.opaque = true
+	}
+}
+
+// walkAll walks the parts of a call to a function outside the check package.
+func (c *collector) walkAll(fun ast.Expr, args []ast.Expr, x *expression) {
+	c.walk(fun, x)
+	for _, arg := range args {
+		c.walk(arg, x)
+	}
+}
+
+// field records the field name passed as a validation's field.
+func (c *collector) field(arg ast.Expr, x *expression) {
+	name, ok := c.name(arg)
+	if !ok {
+		// A closure's field parameter names the field it was given.
+		if c.closures == 0 {
+			c.incomplete = true
+		}
+		x.opaque = true
+		return
+	}
+	x.names = append(x.names, name)
+	x.positions = append(x.positions, arg.Pos())
+}
+
+// groupRef records a Ref passed to a field group such as AtLeastOneOf, which
+// validates every field it names.
+func (c *collector) groupRef(arg ast.Expr, x *expression) {
+	call, ok := ast.Unparen(arg).(*ast.CallExpr)
+	if !ok {
+		c.incomplete = true
+		return
+	}
+	if fn, ok := typeutil.Callee(c.pass.TypesInfo, call).(*types.Func); !ok || !fromCheck(fn) || fn.Name() != "Ref" || len(call.Args) != 2 {
+		c.incomplete = true
+		return
+	}
+	c.field(call.Args[1], x)
+}
+
+// name returns the path of the constant field name in arg, reporting names
+// that match no field.
+func (c *collector) name(arg ast.Expr) (string, bool) {
+	name, ok := c.constant(arg)
+	if !ok {
+		return "", false
+	}
+	path, ok := canonical(c.target, name)
+	if !ok {
+		c.pass.Reportf(arg.Pos(), "%s: no field of %s has this name", name, c.target.Obj().Name())
+	}
+	return path, true
+}
+
+// constant returns the value of a constant string expression.
+func (c *collector) constant(e ast.Expr) (string, bool) {
+	tv, ok := c.pass.TypesInfo.Types[e]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+	return constant.StringVal(tv.Value), true
+}
+
+// report reports tagged fields of the target that the call does not validate,
+// and tag rules that no validation of a field satisfies.
+func (c *collector) report(call *ast.CallExpr) {
+	for _, f := range taggedFields(c.target, "", c.groups, nil) {
+		use, ok := c.uses[f.path]
+		switch {
+		case !ok && !c.incomplete && !c.coveredByParent(f.path):
+			c.pass.Reportf(call.Pos(), "%s: tagged but not validated (validate: %s)", f.path, f.tag)
+		case ok && !use.opaque:
+			for _, rule := range tagrules.FieldRules(f.tag) {
+				if !satisfied(rule, use) {
+					c.pass.Reportf(use.pos, "%s: rule %s not validated (validate: %s)", f.path, rule, f.tag)
+				}
+			}
+		}
+	}
+}
+
+// coveredByParent reports whether a field enclosing path is validated, as by
+// Nested, which validates the fields beneath it.
+func (c *collector) coveredByParent(path string) bool {
+	for i := strings.LastIndex(path, "."); i > 0; i = strings.LastIndex(path[:i], ".") {
+		if _, ok := c.uses[path[:i]]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// taggedField is a field with a validate tag, at a dotted path such as "address.zip".
+type taggedField struct {
+	path string
+	tag  string
+}
+
+// taggedFields returns the tagged fields of t and of the structs nested in it,
+// through pointers, slices and maps, with the tags that apply in groups.
+// enclosing guards against recursive types.
+func taggedFields(t types.Type, prefix string, groups []string, enclosing []*types.Struct) []taggedField {
+	st := elemStruct(t)
+	if st == nil {
+		return nil
+	}
+	for _, e := range enclosing {
+		if e == st {
+			return nil
+		}
+	}
+	enclosing = append(enclosing, st)
+
+	var fields []taggedField
+	for _, f := range structFields(st, nil) {
+		tag := tagrules.GroupTag(f.tag, groups)
+		if tag == "-" {
+			continue
+		}
+		path := f.name()
+		if prefix != "" {
+			path = prefix + "." + path
+		}
+		if tag != "" {
+			fields = append(fields, taggedField{path: path, tag: tag})
+		}
+		fields = append(fields, taggedFields(f.v.Type(), path, groups, enclosing)...)
+	}
+	return fields
+}
+
+// structField is a field of a struct type as Check[T] reads it, declared
+// directly or promoted from an embedded struct.
+type structField struct {
+	v   *types.Var
+	tag reflect.StructTag
+}
+
+// name returns the validation name of the field.
+func (f structField) name() string {
+	return tagrules.FieldName(f.v.Name(), f.tag.Get("json"))
+}
+
+// structFields returns the exported fields of st. Fields of embedded structs
+// without a json name are promoted as encoding/json promotes them, unless a
+// shallower field has the same name. enclosing guards against embedded
+// pointers to an enclosing type.
+func structFields(st *types.Struct, enclosing []*types.Struct) []structField {
+	fields := make([]structField, 0, st.NumFields())
+	var embedded []*types.Struct
+	for i := range st.NumFields() {
+		f, tag := st.Field(i), reflect.StructTag(st.Tag(i))
+		if f.Embedded() && tagrules.JSONName(tag.Get("json")) == "" && tag.Get("json") != "-" {
+			if et := embeddedStruct(f.Type()); et != nil {
+				embedded = append(embedded, et)
+				continue
+			}
+		}
+		if f.Exported() {
+			fields = append(fields, structField{v: f, tag: tag})
+		}
+	}
+
+	for _, et := range embedded {
+		if slices.Contains(enclosing, et) {
+			continue
+		}
+		for _, field := range structFields(et, append(enclosing, st)) {
+			shadowed := slices.ContainsFunc(fields, func(f structField) bool { return f.name() == field.name() })
+			if !shadowed {
+				fields = append(fields, field)
+			}
+		}
+	}
+	return fields
+}
+
+// embeddedStruct returns the struct type of an embedded field, through one
+// pointer, or nil if it is not a struct.
+func embeddedStruct(t types.Type) *types.Struct {
+	if p, ok := t.Underlying().(*types.Pointer); ok {
+		t = p.Elem()
+	}
+	st, _ := t.Underlying().(*types.Struct)
+	return st
+}
+
+// canonical returns the path of a field name such as "Items[0].sku", as
+// Check[T] tracks it: indexes stripped and each segment named by its json
+// name, so "items.sku". Segments may use json or Go field names, as with
+// Check[T], and segments beneath a non-struct value, such as map keys, are
+// kept as written. It reports whether every segment names a field.
+func canonical(t types.Type, name string) (string, bool) {
+	segments := strings.Split(tagrules.Normalize(name), ".")
+	for i, segment := range segments {
+		st := elemStruct(t)
+		if st == nil {
+			break
+		}
+		found := false
+		for _, f := range structFields(st, nil) {
+			if name := f.name(); segment == name || segment == f.v.Name() {
+				segments[i], t, found = name, f.v.Type(), true
+				break
+			}
+		}
+		if !found {
+			return strings.Join(segments, "."), false
+		}
+	}
+	return strings.Join(segments, "."), true
+}
+
+// elemStruct returns the struct type of t, or of the elements t points to or holds.
+func elemStruct(t types.Type) *types.Struct {
+	for {
+		switch u := t.Underlying().(type) {
+		case *types.Struct:
+			return u
+		case *types.Pointer:
+			t = u.Elem()
+		case *types.Slice:
+			t = u.Elem()
+		case *types.Array:
+			t = u.Elem()
+		case *types.Map:
+			t = u.Elem()
+		default:
+			return nil
+		}
+	}
+}
+
+// fromCheck reports whether fn is declared in the check package.
+func fromCheck(fn *types.Func) bool {
+	return fn.Pkg() != nil && fn.Pkg().Path() == checkPath
+}
+
+// isCheckType reports whether t is a pointer to the named type of the check package.
+func isCheckType(t types.Type, name string) bool {
+	ptr, ok := t.(*types.Pointer)
+	if !ok {
+		return false
+	}
+	named, ok := types.Unalias(ptr.Elem()).(*types.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == checkPath && named.Obj().Name() == name
+}
+
+// isBuilderFunc reports whether t is a function taking a check builder, like
+// the closures passed to When.
+func isBuilderFunc(t types.Type) bool {
+	sig, ok := t.(*types.Signature)
+	if !ok || sig.Params().Len() != 1 {
+		return false
+	}
+	ptr, ok := sig.Params().At(0).Type().(*types.Pointer)
+	if !ok {
+		return false
+	}
+	named, ok := types.Unalias(ptr.Elem()).(*types.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == checkPath && strings.HasSuffix(named.Obj().Name(), "Builder")
+}
+
+// isSignature reports whether t is a function type.
+func isSignature(t types.Type) bool {
+	_, ok := t.Underlying().(*types.Signature)
+	return ok
+}
+
+// paramAt returns the parameter receiving argument i, accounting for variadics.
+func paramAt(sig *types.Signature, i int) *types.Var {
+	params := sig.Params()
+	if i >= params.Len() {
+		i = params.Len() - 1
+	}
+	param := params.At(i)
+	if sig.Variadic() && i == params.Len()-1 {
+		if s, ok := param.Type().(*types.Slice); ok {
+			return types.NewParam(param.Pos(), param.Pkg(), param.Name(), s.Elem())
+		}
+	}
+	return param
+}
@@ -0,0 +1,19 @@
+package checkvet_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/zoobzio/check/checkvet"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	dir := filepath.Join(analysistest.TestData(), "src", "example")
+	analysistest.Run(t, dir, checkvet.Analyzer, ".")
+}
+
+func TestAnalyzerEmbedded(t *testing.T) {
+	dir := filepath.Join(analysistest.TestData(), "src", "embedded")
+	analysistest.Run(t, dir, checkvet.Analyzer, ".")
+}
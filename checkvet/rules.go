@@ -0,0 +1,50 @@
+package checkvet
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/zoobzio/check/internal/tagrules"
+)
+
+// fieldGroups are the check functions whose Ref arguments are validated fields.
+var fieldGroups = map[string]bool{
+	"AtLeastOneOf": true,
+	"ExactlyOneOf": true,
+	"AtMostOneOf":  true,
+	"AllOrNone":    true,
+}
+
+// recorded holds every validator name a check function records.
+var recorded = func() map[string]bool {
+	names := make(map[string]bool)
+	for _, validators := range tagrules.Calls {
+		for _, name := range validators {
+			names[name] = true
+		}
+	}
+	return names
+}()
+
+// satisfied reports whether a field's validations satisfy a tag rule. A rule
+// with alternatives ("uuid|slug") is satisfied by any of them, and a rule that
+// no check function can satisfy is assumed satisfied, since it must be
+// validated by code the analyzer does not know.
+func satisfied(rule tagrules.Rule, use *fieldUse) bool {
+	var applied []string
+	for call := range use.calls {
+		applied = append(applied, tagrules.Calls[call]...)
+	}
+	for named := range use.named {
+		applied = append(applied, named)
+	}
+	if tagrules.Satisfied(rule.Name, applied) {
+		return true
+	}
+	for _, alt := range strings.Split(rule.Name, "|") {
+		if !slices.ContainsFunc(tagrules.Validators(alt), func(v string) bool { return recorded[v] }) {
+			return true
+		}
+	}
+	return false
+}
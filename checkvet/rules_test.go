@@ -0,0 +1,39 @@
+package checkvet
+
+import (
+	"testing"
+
+	"github.com/zoobzio/check/internal/tagrules"
+)
+
+func TestSatisfied(t *testing.T) {
+	tests := []struct {
+		rule  string
+		calls []string
+		named []string
+		want  bool
+	}{
+		{"notblank", []string{"NotBlank"}, nil, true},
+		{"notblank", []string{"Required"}, nil, true},
+		{"required", []string{"NonZero"}, nil, false},
+		{"min=8", []string{"LenBetween"}, nil, true},
+		{"gte=0", []string{"NonNegative"}, nil, true},
+		{"http_url", []string{"URL"}, nil, true},
+		{"uuid|slug", []string{"Slug"}, nil, true},
+		{"email", []string{"URL"}, nil, false},
+		{"oneof=a b", nil, []string{"oneof"}, true},
+		{"iso8601", nil, nil, true},
+	}
+	for _, tt := range tests {
+		use := &fieldUse{calls: make(map[string]bool), named: make(map[string]bool)}
+		for _, c := range tt.calls {
+			use.calls[c] = true
+		}
+		for _, n := range tt.named {
+			use.named[n] = true
+		}
+		if got := satisfied(tagrules.Parse(tt.rule)[0], use); got != tt.want {
+			t.Errorf("satisfied(%q, %v %v) = %v, want %v", tt.rule, tt.calls, tt.named, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package embedded
+
+import "github.com/zoobzio/check"
+
+type Base struct {
+	ID string `json:"id" validate:"required,uuid"`
+}
+
+type Audit struct {
+	By string `json:"by" validate:"required"`
+}
+
+// User promotes the fields of Base, which has no json name, and nests those of
+// Audit under "audit", as encoding/json does.
+type User struct {
+	Base
+	*Audit `json:"audit"`
+	Name   string `json:"name" validate:"required"`
+}
+
+func (u User) Covered() *check.Result {
+	return check.Check[User](
+		check.Str(u.ID, "id").Required().UUID().V(),
+		check.Str(u.Name, "name").Required().V(),
+		check.Str(u.By, "audit.by").Required().V(),
+	)
+}
+
+func (u User) Missing() *check.Result {
+	return check.Check[User]( // want `id: tagged but not validated \(validate: required,uuid\)`
+		check.Str(u.Name, "name").Required().V(),
+		check.Str(u.By, "audit.by").Required().V(),
+		check.Str(u.Name, "base.id").V(), // want `base.id: no field of User has this name`
+	)
+}
+
+// Shadowed names the ID of Shadowed itself, which hides the one in Base.
+type Shadowed struct {
+	Base
+	ID int `json:"id" validate:"gte=1"`
+}
+
+func (s Shadowed) Validate() *check.Result {
+	return check.Check[Shadowed](
+		check.Num(s.ID, "id").Min(1).V(),
+	)
+}
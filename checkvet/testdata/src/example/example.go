@@ -0,0 +1,122 @@
+package example
+
+import "github.com/zoobzio/check"
+
+type Address struct {
+	Street string `json:"street" validate:"required"`
+	Zip    string `json:"zip" validate:"required,len=5"`
+}
+
+func (a Address) Validate() *check.Result {
+	return check.Check[Address](
+		check.Str(a.Street, "street").Required().V(),
+		check.Str(a.Zip, "zip").Required().Len(5).V(),
+	)
+}
+
+type Signup struct {
+	Email    string  `json:"email" validate:"required,email"`
+	Password string  `json:"password" validate:"required,min=8"`
+	Confirm  string  `json:"confirm" validate:"eqfield=Password"`
+	Age      int     `json:"age" validate:"gte=18"`
+	Nickname *string `json:"nickname" validate:"omitempty,max=20"`
+	Role     string  `json:"role" validate:"oneof=admin member"`
+	Address  Address `json:"address"`
+}
+
+// Covered validates every tag.
+func (s Signup) Covered() error {
+	return check.Check[Signup](
+		check.Str(s.Email, "email").Required().Email().V(),
+		check.Str(s.Password, "password").Required().MinLen(8).V(),
+		check.EqualField(s.Confirm, s.Password, "confirm", "password"),
+		check.Num(s.Age, "age").Min(18).V(),
+		check.NilOr(s.Nickname, func(v string) *check.Validation { return check.MaxLen(v, 20, "nickname") }),
+		check.Str(s.Role, "role").Check("oneof", func(v string) bool { return v == "admin" || v == "member" }, "must be a role").V(),
+		check.Nested(s.Address, "address"),
+	).Err()
+}
+
+func (s Signup) Missing() error {
+	return check.Check[Signup]( // want `age: tagged but not validated \(validate: gte=18\)` `address.zip: tagged but not validated`
+		check.Str(s.Email, "email").Required().Email().V(),
+		check.Str(s.Password, "password").Required().MinLen(8).V(),
+		check.EqualField(s.Confirm, s.Password, "confirm", "password"),
+		check.OptStr(s.Nickname, "nickname").MaxLen(20).V(),
+		check.OneOf(s.Role, []string{"admin", "member"}, "role"),
+		check.Str(s.Address.Street, "address.street").Required().V(),
+	).Err()
+}
+
+func (s Signup) Mismatched() error {
+	return check.Check[Signup](
+		check.Str(s.Email, "email").Required().V(),                 // want `email: rule email not validated \(validate: required,email\)`
+		check.Str(s.Password, "pasword").Required().MinLen(8).V(),  // want `pasword: no field of Signup has this name`
+		check.Str(s.Password, "password").Required().MaxLen(8).V(), // want `password: rule min=8 not validated`
+		check.EqualField(s.Confirm, s.Password, "confirm", "pass"), // want `pass: no field of Signup has this name`
+		check.Num(s.Age, "age").Min(18).V(),
+		check.OptStr(s.Nickname, "nickname").MaxLen(20).V(),
+		check.Str(s.Role, "role").When(s.Role != "", func(b *check.StrBuilder) { b.OneOf([]string{"admin", "member"}) }).V(),
+		check.Nested(s.Address, "address"),
+	).Err()
+}
+
+// Opaque validations may name fields out of sight, so missing fields are not reported.
+func (s Signup) Opaque() error {
+	return check.CheckIn[Signup]([]string{"create"},
+		check.Str(s.Email, "email").Required().Email().V(),
+		s.rest(),
+	).Err()
+}
+
+func (s Signup) rest() *check.Validation { return nil }
+
+// Custom closures receive the field name, so their rules are not checked.
+func (s Signup) Custom() error {
+	return check.Check[Signup](
+		check.Str(s.Email, "email").Required().Custom(func(v, field string) *check.Validation { return check.Email(v, field) }).V(),
+		check.Group("create",
+			check.Str(s.Password, "password").Required().MinLen(8).V(),
+		),
+		check.AnyOf("confirm",
+			check.EqualField(s.Confirm, s.Password, "confirm", "password"),
+		),
+		check.Num(s.Age, "age").GreaterThanOrEqual(18).V(),
+		check.OptStr(s.Nickname, "nickname").MaxLen(20).V(),
+		check.Str(s.Role, "role").OneOf([]string{"admin", "member"}).V(),
+		check.Nested(s.Address, "address"),
+	).Err()
+}
+
+// Go field names are accepted, as by Check[T].
+func (a Address) GoNames() *check.Result {
+	return check.Check[Address](
+		check.Str(a.Street, "Street").Required().V(),
+		check.Str(a.Zip, "Zip").Required().V(), // want `zip: rule len=5 not validated`
+	)
+}
+
+type User struct {
+	ID    string `json:"id" validate:"required,uuid" validate_create:"-"`
+	Email string `json:"email" validate:"required,email"`
+}
+
+// CheckIn verifies the tags of its groups.
+func (u User) Create() *check.Result {
+	return check.CheckIn[User]([]string{"create"},
+		check.Str(u.Email, "email").Required().Email().V(),
+	)
+}
+
+func (u User) Update() *check.Result {
+	return check.CheckIn[User]([]string{"update"}, // want `id: tagged but not validated`
+		check.Str(u.Email, "email").Required().Email().V(),
+	)
+}
+
+// Groups that are not constant leave the tags unknown.
+func (u User) In(groups []string) *check.Result {
+	return check.CheckIn[User](groups,
+		check.Str(u.Email, "email").Required().Email().V(),
+	)
+}
@@ -0,0 +1,12 @@
+module example
+
+go 1.24.0
+
+require github.com/zoobzio/check v0.0.0
+
+require (
+	github.com/zoobzio/sentinel v1.0.2 // indirect
+	golang.org/x/exp v0.0.0-20260112195511-716be5621a96 // indirect
+)
+
+replace github.com/zoobzio/check => ../../../..
@@ -0,0 +1,86 @@
+package check
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Clock provides the current time to now-relative validators.
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc adapts a function to the Clock interface.
+type ClockFunc func() time.Time
+
+// Now returns the result of calling f.
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// SystemClock is the Clock backed by time.Now.
+var SystemClock Clock = ClockFunc(time.Now)
+
+// clock holds the package clock used by now-relative validators.
+var clock atomic.Pointer[Clock]
+
+// SetClock replaces the clock used by now-relative validators such as
+// [BeforeNow], [InFuture] and [WithinDuration], and by builders without their
+// own clock. It returns a function that restores the previous clock. A nil
+// Clock restores the system clock.
+//
+// The clock is shared by the whole package, so tests that set it must not run
+// in parallel with tests relying on it. To scope a clock to one validation, use
+// the *At variants such as [BeforeNowAt], or [TimeBuilder.Clock].
+//
+//	restore := check.SetClock(check.NewFakeClock(fixed))
+//	defer restore()
+func SetClock(c Clock) (restore func()) {
+	if c == nil {
+		c = SystemClock
+	}
+	prev := clock.Swap(&c)
+	return func() { clock.Store(prev) }
+}
+
+// currentTime returns the time from the package clock.
+func currentTime() time.Time {
+	if c := clock.Load(); c != nil {
+		return (*c).Now()
+	}
+	return time.Now()
+}
+
+// FakeClock is a Clock that only moves when told to, for deterministic tests.
+// It is safe for concurrent use.
+type FakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFakeClock creates a FakeClock set to t.
+func NewFakeClock(t time.Time) *FakeClock {
+	return &FakeClock{now: t}
+}
+
+// Now returns the clock's current time.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Set moves the clock to t.
+func (c *FakeClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = t
+}
+
+// Advance moves the clock forward by d, or backward if d is negative.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
@@ -0,0 +1,124 @@
+package check
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewFakeClock(start)
+	if !c.Now().Equal(start) {
+		t.Errorf("expected %v, got %v", start, c.Now())
+	}
+	c.Advance(time.Hour)
+	if !c.Now().Equal(start.Add(time.Hour)) {
+		t.Errorf("expected advance, got %v", c.Now())
+	}
+	c.Advance(-2 * time.Hour)
+	if !c.Now().Equal(start.Add(-time.Hour)) {
+		t.Errorf("expected rewind, got %v", c.Now())
+	}
+	c.Set(start)
+	if !c.Now().Equal(start) {
+		t.Errorf("expected set, got %v", c.Now())
+	}
+}
+
+func TestSetClock(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	c := NewFakeClock(now)
+	restore := SetClock(c)
+	defer restore()
+
+	t.Run("free functions", func(t *testing.T) {
+		past, future := now.Add(-time.Second), now.Add(time.Second)
+		tests := []struct {
+			name    string
+			v       *Validation
+			wantErr bool
+		}{
+			{"BeforeNow past", BeforeNow(past, "f"), false},
+			{"BeforeNow now", BeforeNow(now, "f"), true},
+			{"AfterNow future", AfterNow(future, "f"), false},
+			{"AfterNow now", AfterNow(now, "f"), true},
+			{"BeforeOrEqualNow now", BeforeOrEqualNow(now, "f"), false},
+			{"BeforeOrEqualNow future", BeforeOrEqualNow(future, "f"), true},
+			{"AfterOrEqualNow now", AfterOrEqualNow(now, "f"), false},
+			{"AfterOrEqualNow past", AfterOrEqualNow(past, "f"), true},
+			{"InPast", InPast(past, "f"), false},
+			{"InFuture", InFuture(past, "f"), true},
+			{"WithinDuration inside", WithinDuration(future, time.Second, "f"), false},
+			{"WithinDuration outside", WithinDuration(future, time.Millisecond, "f"), true},
+		}
+		for _, tt := range tests {
+			if tt.v.Failed() != tt.wantErr {
+				t.Errorf("%s: wantErr %v, got %v", tt.name, tt.wantErr, tt.v.Err())
+			}
+		}
+	})
+
+	t.Run("advancing", func(t *testing.T) {
+		deadline := now.Add(time.Minute)
+		if AfterNow(deadline, "f").Failed() {
+			t.Error("expected deadline in the future")
+		}
+		c.Advance(2 * time.Minute)
+		defer c.Set(now)
+		if !AfterNow(deadline, "f").Failed() {
+			t.Error("expected deadline in the past after advancing")
+		}
+	})
+
+	t.Run("builders use package clock", func(t *testing.T) {
+		if Time(now.Add(-time.Second), "f").BeforeNow().V().Failed() {
+			t.Error("expected pass")
+		}
+		own := NewFakeClock(now.Add(-time.Hour))
+		if !Time(now.Add(-time.Second), "f").Clock(own).BeforeNow().V().Failed() {
+			t.Error("expected builder clock to take precedence")
+		}
+	})
+
+	t.Run("nested restore", func(t *testing.T) {
+		inner := SetClock(NewFakeClock(now.Add(time.Hour)))
+		if BeforeNow(now, "f").Failed() {
+			t.Error("expected inner clock")
+		}
+		inner()
+		if !BeforeNow(now, "f").Failed() {
+			t.Error("expected outer clock after restore")
+		}
+	})
+
+	t.Run("nil restores system clock", func(t *testing.T) {
+		r := SetClock(nil)
+		defer r()
+		if !BeforeNow(time.Now().Add(time.Hour), "f").Failed() {
+			t.Error("expected system clock")
+		}
+	})
+}
+
+func TestNowAtVariants(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+	tests := []struct {
+		name    string
+		v       *Validation
+		wantErr bool
+	}{
+		{"BeforeNowAt", BeforeNowAt(past, now, "f"), false},
+		{"AfterNowAt", AfterNowAt(past, now, "f"), true},
+		{"BeforeOrEqualNowAt", BeforeOrEqualNowAt(future, now, "f"), true},
+		{"AfterOrEqualNowAt", AfterOrEqualNowAt(now, now, "f"), false},
+		{"InPastAt", InPastAt(future, now, "f"), true},
+		{"InFutureAt", InFutureAt(future, now, "f"), false},
+		{"WithinDurationAt", WithinDurationAt(future, 2*time.Hour, now, "f"), false},
+	}
+	for _, tt := range tests {
+		if tt.v.Failed() != tt.wantErr {
+			t.Errorf("%s: wantErr %v, got %v", tt.name, tt.wantErr, tt.v.Err())
+		}
+	}
+}
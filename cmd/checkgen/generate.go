@@ -0,0 +1,805 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"go/types"
+	"maps"
+	"path"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/zoobzio/check"
+	"github.com/zoobzio/check/internal/tagrules"
+	"golang.org/x/tools/go/packages"
+)
+
+// checkPath is the import path of the check package.
+const checkPath = "github.com/zoobzio/check"
+
+// generate returns the source of a file declaring Validate methods for the struct
+// types of the package in dir. Only the named types are generated if names is
+// non-empty. The existing output file, if any, is ignored: its errors are not
+// reported and its Validate methods do not count.
+func generate(dir, output string, names []string) ([]byte, error) {
+	pkg, out, err := load(dir, output)
+	if err != nil {
+		return nil, err
+	}
+	return render(pkg, out, names)
+}
+
+// render returns the generated source for a loaded package. out is the
+// absolute path of the output file.
+func render(pkg *packages.Package, out string, names []string) ([]byte, error) {
+	generated := func(obj types.Object) bool {
+		return pkg.Fset.Position(obj.Pos()).Filename == out
+	}
+	targets, err := selectTypes(pkg, names, generated)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{pkg: pkg.Types, targets: make(map[*types.Named]bool), generated: generated, imports: map[string]string{checkPath: "check"}}
+	for _, named := range targets {
+		g.targets[named] = true
+	}
+	var body bytes.Buffer
+	for _, named := range targets {
+		method, methodErr := g.method(named)
+		if methodErr != nil {
+			return nil, methodErr
+		}
+		body.WriteString("\n" + method)
+	}
+
+	var src bytes.Buffer
+	src.WriteString("// Code generated by checkgen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&src, "package %s\n\nimport (\n", pkg.Name)
+	for _, p := range slices.Sorted(maps.Keys(g.imports)) {
+		if name := g.imports[p]; name != path.Base(p) {
+			fmt.Fprintf(&src, "\t%s %q\n", name, p)
+		} else {
+			fmt.Fprintf(&src, "\t%q\n", p)
+		}
+	}
+	src.WriteString(")\n")
+	src.Write(body.Bytes())
+	return format.Source(src.Bytes())
+}
+
+// load loads the package in dir, returning it with the absolute path of the
+// output file. Errors in the output file are ignored, since a previous run's
+// methods may refer to fields that no longer exist.
+func load(dir, output string) (*packages.Package, string, error) {
+	out, err := filepath.Abs(filepath.Join(dir, output))
+	if err != nil {
+		return nil, "", err
+	}
+	// Dependencies are type-checked from source rather than export data, which
+	// ties the loader to the export format of the running toolchain.
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedImports | packages.NeedDeps | packages.NeedTypes | packages.NeedSyntax | packages.NeedTypesInfo,
+		Dir:  dir,
+	}
+	pkgs, err := packages.Load(cfg, ".")
+	if err != nil {
+		return nil, "", err
+	}
+	if len(pkgs) != 1 {
+		return nil, "", fmt.Errorf("expected one package in %s, found %d", dir, len(pkgs))
+	}
+	for _, e := range pkgs[0].Errors {
+		if !strings.HasPrefix(e.Pos, out+":") {
+			return nil, "", e
+		}
+	}
+	return pkgs[0], out, nil
+}
+
+// selectTypes returns the struct types to generate, in source order: the named
+// types, or every struct type with validate tags and no Validate method.
+func selectTypes(pkg *packages.Package, names []string, generated func(types.Object) bool) ([]*types.Named, error) {
+	wanted := make(map[string]bool, len(names))
+	for _, name := range names {
+		wanted[strings.TrimSpace(name)] = true
+	}
+
+	var targets []*types.Named
+	for _, file := range pkg.Syntax {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts, ok := spec.(*ast.TypeSpec)
+				if !ok {
+					continue
+				}
+				named, ok := pkg.TypesInfo.Defs[ts.Name].Type().(*types.Named)
+				if !ok {
+					continue
+				}
+				st, isStruct := named.Underlying().(*types.Struct)
+				switch {
+				case len(names) > 0 && !wanted[ts.Name.Name]:
+					continue
+				case len(names) > 0 && (!isStruct || ts.TypeParams != nil):
+					return nil, fmt.Errorf("%s is not a non-generic struct type", ts.Name.Name)
+				case len(names) > 0 && validates(named, generated) != "":
+					return nil, fmt.Errorf("%s already has a Validate method", ts.Name.Name)
+				case len(names) == 0 && (!isStruct || ts.TypeParams != nil || !tagged(st) || validates(named, generated) != ""):
+					continue
+				}
+				delete(wanted, ts.Name.Name)
+				targets = append(targets, named)
+			}
+		}
+	}
+
+	if missing := slices.Sorted(maps.Keys(wanted)); len(missing) > 0 {
+		return nil, fmt.Errorf("type %s not found in %s", missing[0], pkg.PkgPath)
+	}
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("no struct types with validate tags in %s", pkg.PkgPath)
+	}
+	return targets, nil
+}
+
+// tagged reports whether any field of st has a validate tag.
+func tagged(st *types.Struct) bool {
+	for i := range st.NumFields() {
+		if tag := reflect.StructTag(st.Tag(i)).Get("validate"); tag != "" && tag != "-" {
+			return true
+		}
+	}
+	return false
+}
+
+// validates reports how a type implements [check.Validator]: "value" if its
+// method set has Validate, "pointer" if only its pointer's does, or "".
+// Methods for which generated reports true are ignored.
+func validates(t types.Type, generated func(types.Object) bool) string {
+	for _, candidate := range []struct {
+		t    types.Type
+		kind string
+	}{{t, "value"}, {types.NewPointer(t), "pointer"}} {
+		sel := types.NewMethodSet(candidate.t).Lookup(nil, "Validate")
+		if sel == nil || generated(sel.Obj()) {
+			continue
+		}
+		sig, ok := sel.Type().(*types.Signature)
+		if ok && sig.Params().Len() == 0 && sig.Results().Len() == 1 &&
+			types.TypeString(sig.Results().At(0).Type(), nil) == "*"+checkPath+".Result" {
+			return candidate.kind
+		}
+	}
+	return ""
+}
+
+// generator writes Validate methods for the struct types of a package.
+type generator struct {
+	pkg       *types.Package
+	targets   map[*types.Named]bool   // Types being generated, which validate by value
+	generated func(types.Object) bool // Reports objects declared in the previous output
+	imports   map[string]string       // Import path to package name
+}
+
+// method returns the Validate method for a struct type.
+func (g *generator) method(named *types.Named) (string, error) {
+	name := named.Obj().Name()
+	recv := strings.ToLower(name[:1])
+	st, ok := named.Underlying().(*types.Struct)
+	if !ok {
+		return "", fmt.Errorf("%s is not a struct type", name)
+	}
+
+	var exprs []string
+	for i := range st.NumFields() {
+		f := st.Field(i)
+		if !f.Exported() || reflect.StructTag(st.Tag(i)).Get("validate") == "-" {
+			continue
+		}
+		fieldExprs, err := g.field(recv, st, i)
+		if err != nil {
+			return "", fmt.Errorf("%s.%s: %w", name, f.Name(), err)
+		}
+		exprs = append(exprs, fieldExprs...)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "// Validate validates %s against its validate tags.\n", name)
+	fmt.Fprintf(&b, "func (%s %s) Validate() *check.Result {\n\treturn check.Check[%s](\n", recv, name, name)
+	for _, expr := range exprs {
+		fmt.Fprintf(&b, "\t\t%s,\n", expr)
+	}
+	b.WriteString("\t)\n}\n")
+	return b.String(), nil
+}
+
+// field returns the validations for field i of st, accessed through recv.
+func (g *generator) field(recv string, st *types.Struct, i int) ([]string, error) {
+	f := st.Field(i)
+	tag := reflect.StructTag(st.Tag(i))
+	name := tagrules.FieldName(f.Name(), tag.Get("json"))
+	expr := recv + "." + f.Name()
+
+	rules, elemTag := tagrules.SplitDive(tag.Get("validate"))
+	var presence, value, related []string
+	var valueRules []tagrules.Rule
+	for _, rule := range rules {
+		var result string
+		var err error
+		switch {
+		case tagrules.Conditional[rule.Name] != "":
+			result, err = g.conditional(recv, st, f, rule, name)
+		case tagrules.CrossField[rule.Name] != "":
+			result, err = g.crossField(recv, st, f, rule, name)
+		case rule.Name == "required" && isPointer(f.Type()):
+			presence = append(presence, fmt.Sprintf("check.NotNil(%s, %q)", expr, name))
+			continue
+		case rule.Name == "required" && types.IsInterface(f.Type()):
+			presence = append(presence, fmt.Sprintf("check.NotNilInterface(%s, %q)", expr, name))
+			continue
+		default:
+			valueRules = append(valueRules, rule)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		related = append(related, result)
+	}
+
+	v, err := g.value(expr, strconv.Quote(name), f.Type(), valueRules, elemTag)
+	if err != nil {
+		return nil, err
+	}
+	if v != "" {
+		value = append(value, v)
+	}
+	if nested := g.nested(expr, name, f.Type()); nested != "" {
+		value = append(value, nested)
+	}
+	return slices.Concat(presence, value, related), nil
+}
+
+// builder describes the check builder used for a value type.
+type builder struct {
+	ctor    string       // Constructor, e.g. "check.Str"
+	typ     string       // Builder type for When closures, e.g. "*check.StrBuilder" or "*check.NumBuilder"
+	typArgs []types.Type // Type arguments of typ, if generic
+	conv    string       // Conversion applied to the value, e.g. "string" for named string types
+	zero    string       // Format of the omitempty condition; empty for pointers, which the Opt builders skip when nil
+	kind    ruleKind     // Rules the builder interprets
+	elem    types.Type   // Element type of slices and maps
+	methods reflect.Type // Builder type, to check that a rule's method exists
+}
+
+// ruleKind selects the rule table for a builder.
+type ruleKind int
+
+const (
+	stringRules ruleKind = iota
+	numberRules
+	timeRules
+	sliceRules
+	mapRules
+)
+
+// builderFor returns the builder for values of type t, or false if t has none.
+func (g *generator) builderFor(t types.Type) (builder, bool) {
+	ptr, isPtr := t.(*types.Pointer)
+	if isPtr {
+		t = ptr.Elem()
+	}
+	opt := func(value, pointer builder) (builder, bool) {
+		if isPtr {
+			return pointer, true
+		}
+		return value, true
+	}
+	conv := func(want types.Type) string {
+		switch {
+		case types.Identical(t, want):
+			return ""
+		case isPtr:
+			return "(*" + types.TypeString(want, nil) + ")"
+		}
+		return types.TypeString(want, nil)
+	}
+
+	if isTime(t) {
+		return opt(
+			builder{ctor: "check.Time", typ: "*check.TimeBuilder", zero: "!%s.IsZero()", kind: timeRules, methods: reflect.TypeFor[*check.TimeBuilder]()},
+			builder{ctor: "check.OptTime", kind: timeRules, methods: reflect.TypeFor[*check.OptTimeBuilder]()},
+		)
+	}
+	str := types.Typ[types.String]
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		switch info := u.Info(); {
+		case info&types.IsString != 0:
+			return opt(
+				builder{ctor: "check.Str", typ: "*check.StrBuilder", conv: conv(str), zero: `%s != ""`, kind: stringRules, methods: reflect.TypeFor[*check.StrBuilder]()},
+				builder{ctor: "check.OptStr", conv: conv(str), kind: stringRules, methods: reflect.TypeFor[*check.OptStrBuilder]()},
+			)
+		case info&(types.IsInteger|types.IsFloat) != 0:
+			return opt(
+				builder{ctor: "check.Num", typ: "*check.NumBuilder", typArgs: []types.Type{t}, zero: "%s != 0", kind: numberRules, elem: t, methods: reflect.TypeFor[*check.NumBuilder[int]]()},
+				builder{ctor: "check.OptNum", kind: numberRules, elem: t, methods: reflect.TypeFor[*check.OptNumBuilder[int]]()},
+			)
+		}
+	case *types.Slice:
+		if types.Identical(u.Elem(), str) {
+			strs := types.NewSlice(str)
+			return opt(
+				builder{ctor: "check.StrSlice", typ: "*check.StrSliceBuilder", zero: "len(%s) != 0", kind: sliceRules, elem: u.Elem(), methods: reflect.TypeFor[*check.StrSliceBuilder]()},
+				builder{ctor: "check.OptStrSlice", conv: conv(strs), kind: sliceRules, elem: u.Elem(), methods: reflect.TypeFor[*check.OptStrSliceBuilder]()},
+			)
+		}
+		return opt(
+			builder{ctor: "check.Slice", typ: "*check.SliceBuilder", typArgs: []types.Type{u.Elem()}, zero: "len(%s) != 0", kind: sliceRules, elem: u.Elem(), methods: reflect.TypeFor[*check.SliceBuilder[any]]()},
+			builder{ctor: "check.OptSlice", kind: sliceRules, elem: u.Elem(), methods: reflect.TypeFor[*check.OptSliceBuilder[any]]()},
+		)
+	case *types.Map:
+		if types.Identical(u.Key(), str) && types.Identical(u.Elem(), str) && !isPtr {
+			return builder{ctor: "check.StrMap", typ: "*check.StrMapBuilder", zero: "len(%s) != 0", kind: mapRules, elem: u.Elem(), methods: reflect.TypeFor[*check.StrMapBuilder]()}, true
+		}
+		return opt(
+			builder{ctor: "check.Map", typ: "*check.MapBuilder", typArgs: []types.Type{u.Key(), u.Elem()}, zero: "len(%s) != 0", kind: mapRules, elem: u.Elem(), methods: reflect.TypeFor[*check.MapBuilder[string, any]]()},
+			builder{ctor: "check.OptMap", kind: mapRules, elem: u.Elem(), methods: reflect.TypeFor[*check.OptMapBuilder[string, any]]()},
+		)
+	}
+	return builder{}, false
+}
+
+// value returns a builder validation applying rules to expr, named by the Go
+// expression nameExpr, with elemTag applied to its elements. It returns "" if
+// there is nothing to validate.
+func (g *generator) value(expr, nameExpr string, t types.Type, rules []tagrules.Rule, elemTag string) (string, error) {
+	if slices.ContainsFunc(rules, func(r tagrules.Rule) bool { return r.Name == "keys" || r.Name == "endkeys" }) {
+		return "", errors.New("map key rules are not supported")
+	}
+	b, ok := g.builderFor(t)
+	if !ok {
+		for _, rule := range rules {
+			if !tagrules.Modifiers[rule.Name] {
+				return "", unsupported(rule, g.typeString(t))
+			}
+		}
+		if elemTag != "" {
+			return "", fmt.Errorf("cannot dive into %s", g.typeString(t))
+		}
+		return "", nil
+	}
+
+	omit := false
+	var calls []string
+	for _, rule := range rules {
+		if tagrules.Modifiers[rule.Name] {
+			omit = omit || rule.Name == "omitempty"
+			continue
+		}
+		call, err := g.call(b, rule)
+		if err != nil {
+			return "", err
+		}
+		calls = append(calls, call)
+	}
+	each, err := g.each(b, elemTag)
+	if err != nil {
+		return "", err
+	}
+	if each != "" {
+		calls = append(calls, each)
+	}
+	if len(calls) == 0 {
+		return "", nil
+	}
+
+	arg := expr
+	if b.conv != "" {
+		arg = b.conv + "(" + expr + ")"
+	}
+	chain := "." + strings.Join(calls, ".")
+	if omit && b.zero != "" {
+		cond := fmt.Sprintf(b.zero, expr)
+		typ := b.typ
+		if len(b.typArgs) > 0 {
+			args := make([]string, len(b.typArgs))
+			for i, t := range b.typArgs {
+				args[i] = g.typeString(t)
+			}
+			typ += "[" + strings.Join(args, ", ") + "]"
+		}
+		return fmt.Sprintf("%s(%s, %s).When(%s, func(b %s) { b%s }).V()", b.ctor, arg, nameExpr, cond, typ, chain), nil
+	}
+	return fmt.Sprintf("%s(%s, %s)%s.V()", b.ctor, arg, nameExpr, chain), nil
+}
+
+// stringMethods are string rules that take no parameter, by builder method.
+var stringMethods = map[string]string{
+	"required":         "Required",
+	"notblank":         "NotBlank",
+	"email":            "Email",
+	"url":              "URL",
+	"http_url":         "HTTPOrHTTPS",
+	"uuid":             "UUID",
+	"uuid4":            "UUID4",
+	"alpha":            "Alpha",
+	"alphanum":         "AlphaNumeric",
+	"alphaunicode":     "AlphaUnicode",
+	"alphanumunicode":  "AlphaNumericUnicode",
+	"numeric":          "Numeric",
+	"ascii":            "ASCII",
+	"printascii":       "PrintableASCII",
+	"lowercase":        "LowerCase",
+	"uppercase":        "UpperCase",
+	"slug":             "Slug",
+	"ip":               "IP",
+	"ipv4":             "IPv4",
+	"ipv6":             "IPv6",
+	"cidr":             "CIDR",
+	"mac":              "MAC",
+	"hostname":         "Hostname",
+	"hostname_port":    "HostPort",
+	"port":             "Port",
+	"hexcolor":         "HexColor",
+	"hexadecimal":      "Hex",
+	"base64":           "Base64",
+	"base64url":        "Base64URL",
+	"json":             "JSON",
+	"semver":           "Semver",
+	"e164":             "E164",
+	"credit_card":      "CreditCard",
+	"latitude":         "Latitude",
+	"longitude":        "Longitude",
+	"iso3166_1_alpha2": "CountryCode2",
+	"iso3166_1_alpha3": "CountryCode3",
+	"iso4217":          "CurrencyCode",
+	"datauri":          "DataURI",
+	"filepath":         "FilePath",
+}
+
+// stringParamMethods are string rules whose parameter is a string.
+var stringParamMethods = map[string]string{
+	"startswith": "Prefix",
+	"endswith":   "Suffix",
+	"contains":   "Contains",
+	"excludes":   "NotContains",
+}
+
+// numberMethods are number rules whose parameter is a number.
+var numberMethods = map[string]string{
+	"min": "Min",
+	"max": "Max",
+	"gt":  "GreaterThan",
+	"gte": "GreaterThanOrEqual",
+	"lt":  "LessThan",
+	"lte": "LessThanOrEqual",
+}
+
+// countMethods are collection rules by builder kind. min, max and len take a count.
+var countMethods = map[ruleKind]map[string]string{
+	stringRules: {"min": "MinLen", "max": "MaxLen", "len": "Len"},
+	sliceRules:  {"required": "NotEmpty", "unique": "Unique", "min": "MinItems", "max": "MaxItems", "len": "ExactItems"},
+	mapRules:    {"required": "NotEmpty", "unique": "UniqueValues", "min": "MinKeys", "max": "MaxKeys", "len": "ExactKeys"},
+}
+
+// call returns the builder method call for a rule, such as "MinLen(8)".
+func (g *generator) call(b builder, rule tagrules.Rule) (string, error) {
+	method, args, err := g.ruleCall(b, rule)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := b.methods.MethodByName(method); !ok {
+		return "", unsupported(rule, strings.TrimPrefix(b.ctor, "check."))
+	}
+	return method + "(" + args + ")", nil
+}
+
+// ruleCall returns the builder method and arguments for a rule.
+func (g *generator) ruleCall(b builder, rule tagrules.Rule) (string, string, error) {
+	if method, ok := countMethods[b.kind][rule.Name]; ok {
+		if rule.Name == "required" || rule.Name == "unique" {
+			return method, "", nil
+		}
+		if _, err := strconv.Atoi(rule.Param); err != nil {
+			return "", "", fmt.Errorf("invalid count %q for %s", rule.Param, rule.Name)
+		}
+		return method, rule.Param, nil
+	}
+
+	switch b.kind {
+	case stringRules:
+		if method, ok := stringMethods[rule.Name]; ok {
+			return method, "", nil
+		}
+		if method, ok := stringParamMethods[rule.Name]; ok {
+			return method, strconv.Quote(rule.Param), nil
+		}
+		if rule.Name == "oneof" {
+			return "OneOf", "[]string{" + quoteAll(strings.Fields(rule.Param)) + "}", nil
+		}
+	case numberRules:
+		if rule.Name == "required" {
+			// Tracked as required, with the message FromTags reports, for Check.
+			return "Check", fmt.Sprintf(`"required", func(v %s) bool { return v != 0 }, "is required"`, g.typeString(b.elem)), nil
+		}
+		if rule.Name == "oneof" {
+			values := strings.Fields(rule.Param)
+			for _, v := range values {
+				if _, err := literal(b.elem, v); err != nil {
+					return "", "", err
+				}
+			}
+			return "OneOfValues", "[]" + g.typeString(b.elem) + "{" + strings.Join(values, ", ") + "}", nil
+		}
+		if method, ok := numberMethods[rule.Name]; ok {
+			lit, err := literal(b.elem, rule.Param)
+			return method, lit, err
+		}
+	case timeRules:
+		if rule.Name == "required" {
+			return "Required", "", nil
+		}
+	}
+	return "", "", unsupported(rule, strings.TrimPrefix(b.ctor, "check."))
+}
+
+// each returns the builder call validating elements with the rules in elemTag,
+// or Nested for elements whose type validates itself.
+func (g *generator) each(b builder, elemTag string) (string, error) {
+	if b.elem == nil || b.kind == numberRules {
+		if elemTag != "" {
+			return "", fmt.Errorf("cannot dive into %s", strings.TrimPrefix(b.ctor, "check."))
+		}
+		return "", nil
+	}
+	method := "EachV"
+	if b.kind == mapRules {
+		method = "EachValue"
+	}
+
+	if nested := g.nestedFunc(b.elem); nested != "" {
+		if strings.Trim(elemTag, " ,") != "" {
+			return "", fmt.Errorf("rules after dive are not supported for %s elements", g.typeString(b.elem))
+		}
+		return method + "(" + nested + ")", nil
+	}
+	if elemTag == "" {
+		return "", nil
+	}
+
+	rules, innerTag := tagrules.SplitDive(elemTag)
+	if b.ctor == "check.StrSlice" || b.ctor == "check.OptStrSlice" || b.ctor == "check.StrMap" {
+		if b.ctor != "check.StrMap" {
+			method = "Each"
+		}
+		if innerTag != "" {
+			return "", errors.New("cannot dive into string elements")
+		}
+		elem := builder{ctor: "check.Str", kind: stringRules, methods: reflect.TypeFor[*check.StrBuilder]()}
+		calls := make([]string, 0, len(rules))
+		for _, rule := range rules {
+			if tagrules.Modifiers[rule.Name] {
+				return "", fmt.Errorf("%s is not supported after dive into strings", rule.Name)
+			}
+			call, err := g.call(elem, rule)
+			if err != nil {
+				return "", err
+			}
+			calls = append(calls, call)
+		}
+		return fmt.Sprintf("%s(func(b *check.StrBuilder) { b.%s })", method, strings.Join(calls, ".")), nil
+	}
+
+	v, err := g.value("v", "field", b.elem, rules, innerTag)
+	if err != nil || v == "" {
+		return "", err
+	}
+	return fmt.Sprintf("%s(func(v %s, field string) *check.Validation { return %s })", method, g.typeString(b.elem), v), nil
+}
+
+// nested returns a Nested or OptNested validation for a struct field whose
+// type validates itself, or "".
+func (g *generator) nested(expr, name string, t types.Type) string {
+	if ptr, ok := t.(*types.Pointer); ok {
+		if g.validates(ptr.Elem()) != "" {
+			return fmt.Sprintf("check.OptNested(%s, %q)", expr, name)
+		}
+		return ""
+	}
+	switch g.validates(t) {
+	case "value":
+		return fmt.Sprintf("check.Nested(%s, %q)", expr, name)
+	case "pointer":
+		return fmt.Sprintf("check.Nested(&%s, %q)", expr, name)
+	}
+	return ""
+}
+
+// nestedFunc returns the function validating slice or map elements of type t
+// that validate themselves, or "".
+func (g *generator) nestedFunc(t types.Type) string {
+	if ptr, ok := t.(*types.Pointer); ok {
+		if g.validates(ptr.Elem()) != "" {
+			return "check.OptNested"
+		}
+		return ""
+	}
+	if g.validates(t) == "value" {
+		return "check.Nested"
+	}
+	return ""
+}
+
+// validates is [validates], counting the types being generated.
+func (g *generator) validates(t types.Type) string {
+	if named, ok := t.(*types.Named); ok && g.targets[named] {
+		return "value"
+	}
+	if _, ok := t.Underlying().(*types.Struct); !ok {
+		return ""
+	}
+	return validates(t, g.generated)
+}
+
+// crossField returns the validation comparing field f with the field named by the rule.
+func (g *generator) crossField(recv string, st *types.Struct, f *types.Var, rule tagrules.Rule, name string) (string, error) {
+	other, otherName, err := lookupField(st, rule.Param)
+	if err != nil {
+		return "", err
+	}
+	ordered := rule.Name != "eqfield" && rule.Name != "nefield"
+	switch {
+	case !types.Identical(f.Type(), other.Type()) || isPointer(f.Type()):
+		return "", fmt.Errorf("cannot compare %s with %s", g.typeString(f.Type()), g.typeString(other.Type()))
+	case ordered && !isOrdered(f.Type()), !ordered && !types.Comparable(f.Type()):
+		return "", unsupported(rule, g.typeString(f.Type()))
+	}
+	return fmt.Sprintf("check.%s(%s.%s, %s.%s, %q, %q)", tagrules.CrossField[rule.Name], recv, f.Name(), recv, other.Name(), name, otherName), nil
+}
+
+// conditional returns the validation for a conditional presence rule on field f.
+func (g *generator) conditional(recv string, st *types.Struct, f *types.Var, rule tagrules.Rule, name string) (string, error) {
+	value, err := g.presence(recv+"."+f.Name(), f.Type())
+	if err != nil {
+		return "", err
+	}
+	fn := tagrules.Conditional[rule.Name]
+
+	switch rule.Name {
+	case "required_if", "required_unless", "excluded_if":
+		goName, want, ok := strings.Cut(rule.Param, " ")
+		if !ok {
+			return "", errors.New("expected a field name and a value")
+		}
+		other, otherName, err := lookupField(st, goName)
+		if err != nil {
+			return "", err
+		}
+		lit, err := literal(other.Type(), want)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("check.%s(%s, %s.%s, %s, %q, %q)", fn, value, recv, other.Name(), lit, name, otherName), nil
+	}
+
+	refs := []string{value, strconv.Quote(name)}
+	for _, goName := range strings.Fields(rule.Param) {
+		other, otherName, err := lookupField(st, goName)
+		if err != nil {
+			return "", err
+		}
+		set, err := g.presence(recv+"."+other.Name(), other.Type())
+		if err != nil {
+			return "", err
+		}
+		refs = append(refs, fmt.Sprintf("check.Ref(%s, %q)", set, otherName))
+	}
+	return fmt.Sprintf("check.%s(%s)", fn, strings.Join(refs, ", ")), nil
+}
+
+// presence returns an expression that is its zero value exactly when expr is
+// unset, as conditional rules require comparable values.
+func (g *generator) presence(expr string, t types.Type) (string, error) {
+	if types.Comparable(t) {
+		return expr, nil
+	}
+	switch t.Underlying().(type) {
+	case *types.Slice, *types.Map:
+		return "len(" + expr + ") != 0", nil
+	}
+	return "", fmt.Errorf("cannot tell whether a %s is set", g.typeString(t))
+}
+
+// typeString returns t as written in the generated file, recording its imports.
+func (g *generator) typeString(t types.Type) string {
+	return types.TypeString(t, func(p *types.Package) string {
+		if p == g.pkg {
+			return ""
+		}
+		g.imports[p.Path()] = p.Name()
+		return p.Name()
+	})
+}
+
+// lookupField looks up an exported field of st by its Go name, returning it
+// and its validation name.
+func lookupField(st *types.Struct, goName string) (*types.Var, string, error) {
+	for i := range st.NumFields() {
+		if f := st.Field(i); f.Name() == goName && f.Exported() {
+			return f, tagrules.FieldName(f.Name(), reflect.StructTag(st.Tag(i)).Get("json")), nil
+		}
+	}
+	return nil, "", fmt.Errorf("no exported field %s", goName)
+}
+
+// literal returns s as a Go literal of basic type t.
+func literal(t types.Type, s string) (string, error) {
+	basic, ok := t.Underlying().(*types.Basic)
+	if !ok {
+		return "", fmt.Errorf("cannot compare %s with %q", t, s)
+	}
+	var err error
+	switch info := basic.Info(); {
+	case info&types.IsString != 0:
+		return strconv.Quote(s), nil
+	case info&types.IsBoolean != 0:
+		_, err = strconv.ParseBool(s)
+	case info&types.IsUnsigned != 0:
+		_, err = strconv.ParseUint(s, 10, 64)
+	case info&types.IsInteger != 0:
+		_, err = strconv.ParseInt(s, 10, 64)
+	case info&types.IsFloat != 0:
+		_, err = strconv.ParseFloat(s, 64)
+	default:
+		err = errors.ErrUnsupported
+	}
+	if err != nil {
+		return "", fmt.Errorf("invalid %s value %q", t, s)
+	}
+	return s, nil
+}
+
+// quoteAll quotes each string and joins them with ", ".
+func quoteAll(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = strconv.Quote(v)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// isPointer reports whether t is a pointer type.
+func isPointer(t types.Type) bool {
+	_, ok := t.(*types.Pointer)
+	return ok
+}
+
+// isOrdered reports whether t supports the ordering operators.
+func isOrdered(t types.Type) bool {
+	basic, ok := t.Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsOrdered != 0
+}
+
+// isTime reports whether t is time.Time.
+func isTime(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == "time" && named.Obj().Name() == "Time"
+}
+
+// unsupported reports a rule that does not apply to a type or builder.
+func unsupported(rule tagrules.Rule, t string) error {
+	return fmt.Errorf("unsupported rule %s for %s", rule.Name, t)
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/check"
+	"github.com/zoobzio/check/internal/tagrules"
+	"github.com/zoobzio/check/cmd/checkgen/testdata/signup"
+)
+
+func TestGenerate(t *testing.T) {
+	dir := filepath.Join("testdata", "signup")
+	golden, err := os.ReadFile(filepath.Join(dir, "check_gen.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("matches golden file", func(t *testing.T) {
+		// The golden file is present, so this also checks that the previous
+		// output is ignored and regenerating is idempotent.
+		got, err := generate(dir, "check_gen.go", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != string(golden) {
+			t.Errorf("generated source differs from check_gen.go; run go generate ./%s\n%s", dir, got)
+		}
+	})
+
+	pkg, out, err := load(dir, "check_gen.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("selected types", func(t *testing.T) {
+		got, err := render(pkg, out, []string{"Address"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(got), "Signup") || !strings.Contains(string(got), "func (a Address) Validate() *check.Result") {
+			t.Errorf("expected only Address, got\n%s", got)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tests := map[string]string{
+			"UnknownRule":  "UnknownRule.Count: unsupported rule email for Num",
+			"BadParam":     `BadParam.Name: invalid count "abc" for min`,
+			"MissingField": "MissingField.Confirm: no exported field Password",
+			"Mismatched":   "Mismatched.End: cannot compare string with int",
+			"Validated":    "Validated already has a Validate method",
+			"Missing":      "type Missing not found",
+		}
+		invalid, invalidOut, err := load(filepath.Join("testdata", "invalid"), "check_gen.go")
+		if err != nil {
+			t.Fatal(err)
+		}
+		for name, want := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := render(invalid, invalidOut, []string{name})
+				if err == nil || !strings.Contains(err.Error(), want) {
+					t.Errorf("expected error containing %q, got %v", want, err)
+				}
+			})
+		}
+	})
+}
+
+// TestGeneratedMatchesFromTags checks the generated methods against the tag
+// interpreter, which validates the same tags at runtime.
+func TestGeneratedMatchesFromTags(t *testing.T) {
+	site, nick := "not a url", "x"
+	cases := map[string]signup.Signup{
+		"valid": {
+			Email: "a@b.co", Password: "correct-horse", Confirm: "correct-horse", Age: 30, Nickname: &nick,
+			Kind: 1, Tags: []string{"go"}, Scores: []int{1}, Labels: map[string]string{"env": "prod"},
+			Born: time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC), Address: signup.Address{Street: "Main St", Zip: "12345"},
+			Items: []*signup.Item{{SKU: "A1"}},
+		},
+		"invalid": {
+			Email: "nope", Password: "short", Confirm: "other", Age: 12, Score: 2, Role: "root", Website: &site,
+			Nickname: &nick, Level: check.Ptr(11), Kind: 2, Tags: []string{"a", "bb", "c", "dd"}, Scores: []int{-1},
+			Labels: map[string]string{"b": "1"}, Address: signup.Address{Zip: "12"}, Billing: &signup.Address{},
+			Items: []*signup.Item{{}}, Phone: "555-0100",
+		},
+	}
+	zeroAge := cases["valid"]
+	zeroAge.Age = 0
+	cases["zero required number"] = zeroAge
+
+	for name, s := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, want := s.Validate(), check.FromTags(s)
+			if got.Error() != want.Error() {
+				t.Errorf("errors differ:\n got: %s\nwant: %s", got.Error(), want.Error())
+			}
+			// Nested also tracks the parent field, such as "address", so the
+			// generated methods track every field FromTags does, and those.
+			for _, field := range want.Fields() {
+				if _, ok := got.Applied()[field]; !ok {
+					t.Errorf("expected %s to be tracked, got %v", field, got.Fields())
+				}
+			}
+		})
+	}
+}
+
+// TestMethodTables checks that each builder method generated for a rule
+// records a validator satisfying it, so Check[T] accepts the generated code.
+func TestMethodTables(t *testing.T) {
+	tables := []map[string]string{stringMethods, stringParamMethods, numberMethods}
+	for _, methods := range countMethods {
+		tables = append(tables, methods)
+	}
+	for _, methods := range tables {
+		for rule, method := range methods {
+			if !tagrules.Satisfied(rule, tagrules.Calls[method]) {
+				t.Errorf("%s: %s records %v", rule, method, tagrules.Calls[method])
+			}
+		}
+	}
+}
@@ -0,0 +1,35 @@
+// Package invalid holds types whose tags checkgen cannot generate.
+package invalid
+
+import "github.com/zoobzio/check"
+
+// UnknownRule uses a rule that has no builder method.
+type UnknownRule struct {
+	Count int `json:"count" validate:"email"`
+}
+
+// BadParam has a parameter that is not a count.
+type BadParam struct {
+	Name string `json:"name" validate:"min=abc"`
+}
+
+// MissingField compares with a field that does not exist.
+type MissingField struct {
+	Confirm string `json:"confirm" validate:"eqfield=Password"`
+}
+
+// Mismatched compares fields of different types.
+type Mismatched struct {
+	Start int    `json:"start"`
+	End   string `json:"end" validate:"gtfield=Start"`
+}
+
+// Validated already has a Validate method.
+type Validated struct {
+	Name string `json:"name" validate:"required"`
+}
+
+// Validate validates the value.
+func (v Validated) Validate() *check.Result {
+	return check.All(check.Str(v.Name, "name").Required().V())
+}
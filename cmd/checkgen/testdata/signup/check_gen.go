@@ -0,0 +1,41 @@
+// Code generated by checkgen. DO NOT EDIT.
+
+package signup
+
+import (
+	"github.com/zoobzio/check"
+)
+
+// Validate validates Signup against its validate tags.
+func (s Signup) Validate() *check.Result {
+	return check.Check[Signup](
+		check.Str(s.Email, "email").Required().Email().MaxLen(255).V(),
+		check.Str(s.Password, "password").Required().MinLen(8).V(),
+		check.EqualField(s.Confirm, s.Password, "confirm", "password"),
+		check.Num(s.Age, "age").Check("required", func(v int) bool { return v != 0 }, "is required").GreaterThanOrEqual(18).LessThanOrEqual(130).V(),
+		check.Num(s.Score, "score").When(s.Score != 0, func(b *check.NumBuilder[float64]) { b.GreaterThan(0).LessThan(1) }).V(),
+		check.Str(string(s.Role), "role").When(s.Role != "", func(b *check.StrBuilder) { b.OneOf([]string{"admin", "member"}) }).V(),
+		check.OptStr(s.Website, "website").URL().V(),
+		check.NotNil(s.Nickname, "nickname"),
+		check.OptStr(s.Nickname, "nickname").MinLen(2).V(),
+		check.OptNum(s.Level, "level").Max(10).V(),
+		check.Num(s.Kind, "kind").OneOfValues([]uint8{1, 2, 3}).V(),
+		check.StrSlice(s.Tags, "tags").MaxItems(3).Each(func(b *check.StrBuilder) { b.MinLen(2) }).V(),
+		check.Slice(s.Scores, "scores").EachV(func(v int, field string) *check.Validation { return check.Num(v, field).Min(0).V() }).V(),
+		check.StrMap(s.Labels, "labels").EachValue(func(b *check.StrBuilder) { b.Alpha() }).V(),
+		check.Time(s.Born, "born").Required().V(),
+		check.Nested(s.Address, "address"),
+		check.OptNested(s.Billing, "billing"),
+		check.Slice(s.Items, "items").NotEmpty().EachV(check.OptNested).V(),
+		check.RequiredIf(s.Company, s.Kind, 2, "company", "kind"),
+		check.RequiredWith(s.Country, "country", check.Ref(s.Phone, "phone")),
+	)
+}
+
+// Validate validates Address against its validate tags.
+func (a Address) Validate() *check.Result {
+	return check.Check[Address](
+		check.Str(a.Street, "street").Required().V(),
+		check.Str(a.Zip, "zip").Required().Len(5).Numeric().V(),
+	)
+}
@@ -0,0 +1,60 @@
+// Package signup holds tagged types for checkgen's tests.
+package signup
+
+import (
+	"time"
+
+	"github.com/zoobzio/check"
+)
+
+//go:generate go run github.com/zoobzio/check/cmd/checkgen
+
+// Role is a named string type.
+type Role string
+
+// Signup exercises value, pointer, collection and cross-field rules.
+type Signup struct {
+	Email    string            `json:"email" validate:"required,email,max=255"`
+	Password string            `json:"password" validate:"required,min=8"`
+	Confirm  string            `json:"confirm" validate:"eqfield=Password"`
+	Age      int               `json:"age" validate:"required,gte=18,lte=130"`
+	Score    float64           `json:"score" validate:"omitempty,gt=0,lt=1"`
+	Role     Role              `json:"role" validate:"omitempty,oneof=admin member"`
+	Website  *string           `json:"website" validate:"omitempty,url"`
+	Nickname *string           `json:"nickname" validate:"required,min=2"`
+	Level    *int              `json:"level" validate:"max=10"`
+	Kind     uint8             `json:"kind" validate:"oneof=1 2 3"`
+	Tags     []string          `json:"tags" validate:"max=3,dive,min=2"`
+	Scores   []int             `json:"scores" validate:"dive,min=0"`
+	Labels   map[string]string `json:"labels" validate:"dive,alpha"`
+	Born     time.Time         `json:"born" validate:"required"`
+	Address  Address           `json:"address"`
+	Billing  *Address          `json:"billing"`
+	Items    []*Item           `json:"items" validate:"required"`
+	Company  string            `json:"company" validate:"required_if=Kind 2"`
+	Phone    string            `json:"phone"`
+	Country  string            `json:"country" validate:"required_with=Phone"`
+	Internal string            `validate:"-"`
+	secret   string
+}
+
+// Address is validated through Signup with Nested.
+type Address struct {
+	Street string `json:"street" validate:"required"`
+	Zip    string `json:"zip" validate:"required,len=5,numeric"`
+}
+
+// Item has a hand-written Validate method, so it is not generated.
+type Item struct {
+	SKU string `json:"sku" validate:"required"`
+}
+
+// Validate validates the item.
+func (i *Item) Validate() *check.Result {
+	return check.All(check.Str(i.SKU, "sku").Required().V())
+}
+
+// Untagged has no validate tags, so it is not generated.
+type Untagged struct {
+	Name string
+}
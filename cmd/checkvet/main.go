@@ -0,0 +1,17 @@
+// Command checkvet reports validate tags that calls to check.Check[T] do not
+// cover. See package github.com/zoobzio/check/checkvet for what it reports.
+//
+// Usage:
+//
+//	checkvet ./...
+//	go vet -vettool=$(which checkvet) ./...
+package main
+
+import (
+	"github.com/zoobzio/check/checkvet"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(checkvet.Analyzer)
+}
@@ -0,0 +1,134 @@
+package check
+
+import (
+	"errors"
+	"strings"
+)
+
+// AnyOf passes if at least one of the validations passes.
+// On failure the alternatives' messages are joined with "or", e.g.
+// "must be a valid UUID or must be a valid slug", with code "anyof"; the
+// message is kept as-is by [FieldError.Localize]. The validation is tracked
+// under field by the alternatives' names joined with "|", e.g. "uuid|slug",
+// which satisfies a validate tag rule of the same form. Nil validations are ignored.
+//
+// Usage:
+//
+//	check.AnyOf("id", check.UUID(id, "id"), check.Slug(id, "id"))
+func AnyOf(field string, validations ...*Validation) *Validation {
+	alternatives := resolved(validations)
+	if len(alternatives) == 0 {
+		return nil
+	}
+	name := alternativesName(alternatives)
+
+	messages := make([]string, 0, len(alternatives))
+	for _, v := range alternatives {
+		if !v.Failed() {
+			return validation(nil, field, name).with("validators", name)
+		}
+		messages = append(messages, failureMessage(v))
+	}
+
+	err := &FieldError{Field: field, Message: strings.Join(messages, " or "), Code: "anyof", custom: true}
+	return validation(err, field, name).with("validators", name)
+}
+
+// ExactlyOne passes if exactly one of the validations passes.
+// When none pass, the alternatives' messages are joined with "or", like [AnyOf].
+// When more than one passes, it fails with "must satisfy exactly one of its
+// alternatives", which [FieldError.Localize] translates; the joined messages of
+// failed alternatives are kept as-is. Either error has code "exactlyone".
+// It is tracked under field as "exactlyone" and by the alternatives' names
+// joined with "|", like [AnyOf]. Nil validations are ignored.
+//
+// Usage:
+//
+//	check.ExactlyOne("contact", check.Email(c, "contact"), check.E164(c, "contact"))
+func ExactlyOne(field string, validations ...*Validation) *Validation {
+	alternatives := resolved(validations)
+	if len(alternatives) == 0 {
+		return nil
+	}
+	names := make([]string, len(alternatives))
+	var failed []string
+	for i, v := range alternatives {
+		names[i] = ruleName(v)
+		if v.Failed() {
+			failed = append(failed, failureMessage(v))
+		}
+	}
+
+	var err error
+	switch passed := len(alternatives) - len(failed); {
+	case passed == 0:
+		err = &FieldError{Field: field, Message: strings.Join(failed, " or "), Code: "exactlyone", custom: true}
+	case passed > 1:
+		err = fieldErr(field, "must satisfy exactly one of its alternatives")
+	}
+	return validation(err, field, "exactlyone", strings.Join(names, "|")).with("validators", names)
+}
+
+// Not inverts a validation: it fails with message when v passes, and passes when v fails.
+// The field is taken from v, and the validation is tracked as "not:" followed by
+// v's validators, e.g. "not:ip". The message is kept as-is by [FieldError.Localize].
+// Not of a nil validation is nil.
+//
+// Usage:
+//
+//	check.Not(check.IP(host, "host"), "must be a hostname, not an IP address")
+func Not(v *Validation, message string) *Validation {
+	if v == nil {
+		return nil
+	}
+	v.resolve()
+	name := "not:" + ruleName(v)
+	if v.err != nil {
+		return validation(nil, v.field, name)
+	}
+	err := &FieldError{Field: v.field, Message: message, Code: "not", custom: true}
+	return validation(err, v.field, name).with("validator", ruleName(v))
+}
+
+// resolved returns the non-nil validations, evaluated.
+func resolved(validations []*Validation) []*Validation {
+	out := make([]*Validation, 0, len(validations))
+	for _, v := range validations {
+		if v == nil {
+			continue
+		}
+		v.resolve()
+		out = append(out, v)
+	}
+	return out
+}
+
+// ruleName names a validation by its validators joined with "+",
+// e.g. "required+email".
+func ruleName(v *Validation) string {
+	return strings.Join(v.validators, "+")
+}
+
+// alternativesName joins the names of alternative validations with "|".
+func alternativesName(validations []*Validation) string {
+	names := make([]string, len(validations))
+	for i, v := range validations {
+		names[i] = ruleName(v)
+	}
+	return strings.Join(names, "|")
+}
+
+// failureMessage describes a failed validation by its messages joined with "and".
+func failureMessage(v *Validation) string {
+	errs := flatten(v.err)
+	messages := make([]string, 0, len(errs))
+	for _, err := range errs {
+		var fe *FieldError
+		if errors.As(err, &fe) {
+			messages = append(messages, fe.Message)
+		} else {
+			messages = append(messages, err.Error())
+		}
+	}
+	return strings.Join(messages, " and ")
+}
@@ -0,0 +1,181 @@
+package check
+
+import "testing"
+
+func TestAnyOf(t *testing.T) {
+	t.Run("passes when one alternative passes", func(t *testing.T) {
+		for _, id := range []string{"550e8400-e29b-41d4-a716-446655440000", "my-post"} {
+			r := All(AnyOf("id", UUID(id, "id"), Slug(id, "id")))
+			if r.Err() != nil {
+				t.Errorf("%q: expected pass, got %v", id, r.Err())
+			}
+			if !r.HasValidator("id", "uuid|slug") {
+				t.Errorf("expected combined tracking, got %v", r.Applied())
+			}
+			if applied := r.AppliedValidators("id"); len(applied) != 1 || applied[0].Params["validators"] != "uuid|slug" {
+				t.Errorf("expected validators param on pass, got %v", applied)
+			}
+		}
+	})
+
+	t.Run("combines messages on failure", func(t *testing.T) {
+		v := AnyOf("id", UUID("Not A Slug", "id"), Slug("Not A Slug", "id"))
+		errs := GetFieldErrors(All(v))
+		if len(errs) != 1 {
+			t.Fatalf("expected 1 error, got %v", v.Err())
+		}
+		fe := errs[0]
+		if fe.Message != "must be a valid UUID or must contain only lowercase letters, numbers, and hyphens" {
+			t.Errorf("unexpected message: %s", fe.Message)
+		}
+		if fe.Code != "anyof" || fe.Params["validators"] != "uuid|slug" {
+			t.Errorf("unexpected code/params: %s %v", fe.Code, fe.Params)
+		}
+		if fe.Localize(English()) != fe.Message {
+			t.Error("expected message to be kept by Localize")
+		}
+	})
+
+	t.Run("joins multiple failures of an alternative", func(t *testing.T) {
+		v := AnyOf("code", Str("", "code").Required().MinLen(2).V(), Int(0, "code").Positive().V())
+		if v.Error() != "code: is required and must be at least 2 characters or must be positive" {
+			t.Errorf("unexpected: %s", v.Error())
+		}
+		if !All(v).HasValidator("code", "required+min|gt") {
+			t.Errorf("unexpected tracking: %v", v.validators)
+		}
+	})
+
+	t.Run("satisfies alternative tag rules", func(t *testing.T) {
+		type post struct {
+			ID string `json:"id" validate:"uuid|slug"`
+		}
+		r := Check[post](AnyOf("id", UUID("my-post", "id"), Slug("my-post", "id")))
+		if r.Err() != nil {
+			t.Errorf("expected pass, got %v", r.Err())
+		}
+	})
+
+	t.Run("nil alternatives", func(t *testing.T) {
+		if AnyOf("id") != nil || AnyOf("id", nil) != nil {
+			t.Error("expected nil")
+		}
+	})
+}
+
+func TestExactlyOne(t *testing.T) {
+	t.Run("passes when exactly one passes", func(t *testing.T) {
+		r := All(ExactlyOne("contact", Email("a@b.co", "contact"), E164("a@b.co", "contact")))
+		if r.Err() != nil {
+			t.Errorf("expected pass, got %v", r.Err())
+		}
+		for _, name := range []string{"exactlyone", "email|e164"} {
+			if !r.HasValidator("contact", name) {
+				t.Errorf("expected %s to be tracked", name)
+			}
+		}
+	})
+
+	t.Run("fails when none pass", func(t *testing.T) {
+		v := ExactlyOne("contact", Email("x", "contact"), E164("x", "contact"))
+		if v.Error() != "contact: must be a valid email address or must be a valid E.164 phone number" {
+			t.Errorf("unexpected: %s", v.Error())
+		}
+		fe := GetFieldErrors(All(v))[0]
+		if fe.Code != "exactlyone" || fe.Localize(Catalog{"exactlyone": "x"}) != fe.Message {
+			t.Errorf("unexpected code or localization: %+v", fe)
+		}
+	})
+
+	t.Run("fails when more than one passes", func(t *testing.T) {
+		v := ExactlyOne("n", Int(4, "n").Even().V(), Int(4, "n").Positive().V(), Int(4, "n").Odd().V())
+		if v.Error() != "n: must satisfy exactly one of its alternatives" {
+			t.Errorf("unexpected: %s", v.Error())
+		}
+		fe := GetFieldErrors(All(v))[0]
+		if fe.Code != "exactlyone" || fe.Localize(Catalog{"exactlyone": "x"}) != "x" {
+			t.Errorf("unexpected code or localization: %+v", fe)
+		}
+	})
+
+	t.Run("alternatives with parameterized messages", func(t *testing.T) {
+		tags := []string{"a", "b"}
+		v := ExactlyOne("tags", ExactItems(tags, 2, "tags"), MinItems(tags, 1, "tags"))
+		if v.Error() != "tags: must satisfy exactly one of its alternatives" {
+			t.Errorf("unexpected: %s", v.Error())
+		}
+	})
+}
+
+func TestNot(t *testing.T) {
+	t.Run("fails when the validation passes", func(t *testing.T) {
+		v := Not(IP("10.0.0.1", "host"), "must be a hostname, not an IP address")
+		if v.Error() != "host: must be a hostname, not an IP address" {
+			t.Errorf("unexpected: %s", v.Error())
+		}
+		fe := GetFieldErrors(All(v))[0]
+		if fe.Code != "not" || fe.Params["validator"] != "ip" {
+			t.Errorf("unexpected code/params: %s %v", fe.Code, fe.Params)
+		}
+	})
+
+	t.Run("passes when the validation fails", func(t *testing.T) {
+		r := All(Not(IP("example.com", "host"), "must not be an IP"))
+		if r.Err() != nil {
+			t.Errorf("expected pass, got %v", r.Err())
+		}
+		if !r.HasValidator("host", "not:ip") {
+			t.Errorf("unexpected tracking: %v", r.Applied())
+		}
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		if Not(nil, "x") != nil {
+			t.Error("expected nil")
+		}
+	})
+}
+
+func TestStrBuilderEitherAndNot(t *testing.T) {
+	uuidOrSlug := func(b *StrBuilder) *StrBuilder {
+		return b.Either(
+			func(b *StrBuilder) { b.UUID() },
+			func(b *StrBuilder) { b.Slug() },
+		)
+	}
+
+	t.Run("either", func(t *testing.T) {
+		if v := uuidOrSlug(Str("my-post", "id")).V(); v.Failed() {
+			t.Errorf("expected pass, got %v", v.Err())
+		}
+		v := uuidOrSlug(Str("Not A Slug", "id").Required()).V()
+		if !v.Failed() || !All(v).HasValidator("id", "uuid|slug") {
+			t.Errorf("expected tracked failure, got %v %v", v.Err(), v.validators)
+		}
+	})
+
+	t.Run("either is recorded when skipped by bail", func(t *testing.T) {
+		r := All(uuidOrSlug(Str("", "id").Bail().Required()).V())
+		if len(GetFieldErrors(r)) != 1 || !r.HasValidator("id", "uuid|slug") {
+			t.Errorf("unexpected: %v %v", r.Err(), r.Applied())
+		}
+	})
+
+	t.Run("not", func(t *testing.T) {
+		v := Str("10.0.0.1", "host").Required().Not(func(b *StrBuilder) { b.IP() }, "must not be an IP").V()
+		if v.Error() != "host: must not be an IP" {
+			t.Errorf("unexpected: %s", v.Error())
+		}
+	})
+
+	t.Run("optional", func(t *testing.T) {
+		v := OptStr(nil, "id").Either(func(b *StrBuilder) { b.UUID() }, func(b *StrBuilder) { b.Slug() }).V()
+		if v.Failed() || !All(v).HasValidator("id", "uuid|slug") {
+			t.Errorf("expected skipped alternatives to pass and be recorded, got %v", All(v).Applied())
+		}
+		s := "10.0.0.1"
+		if v := OptStr(&s, "host").Not(func(b *StrBuilder) { b.IP() }, "must not be an IP").V(); !v.Failed() {
+			t.Error("expected failure")
+		}
+	})
+}
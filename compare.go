@@ -10,7 +10,7 @@ func Equal[T comparable](v, expected T, field string) *Validation {
 	if v != expected {
 		err = fieldErrf(field, "must equal %v", expected)
 	}
-	return validation(err, field, "eq")
+	return validation(err, field, "eq").with("value", expected)
 }
 
 // NotEqual validates that two values are not equal.
@@ -19,7 +19,7 @@ func NotEqual[T comparable](v, other T, field string) *Validation {
 	if v == other {
 		err = fieldErrf(field, "must not equal %v", other)
 	}
-	return validation(err, field, "ne")
+	return validation(err, field, "ne").with("value", other)
 }
 
 // EqualField validates that a value equals another field's value.
@@ -29,7 +29,7 @@ func EqualField[T comparable](v, other T, field, otherField string) *Validation
 	if v != other {
 		err = fieldErrf(field, "must equal %s", otherField)
 	}
-	return validation(err, field, "eqfield")
+	return validation(err, field, "eqfield").with("other", otherField)
 }
 
 // NotEqualField validates that a value does not equal another field's value.
@@ -38,7 +38,7 @@ func NotEqualField[T comparable](v, other T, field, otherField string) *Validati
 	if v == other {
 		err = fieldErrf(field, "must not equal %s", otherField)
 	}
-	return validation(err, field, "nefield")
+	return validation(err, field, "nefield").with("other", otherField)
 }
 
 // GreaterThanField validates that a value is greater than another field's value.
@@ -47,7 +47,7 @@ func GreaterThanField[T constraints.Ordered](v, other T, field, otherField strin
 	if v <= other {
 		err = fieldErrf(field, "must be greater than %s", otherField)
 	}
-	return validation(err, field, "gtfield")
+	return validation(err, field, "gtfield").with("other", otherField)
 }
 
 // LessThanField validates that a value is less than another field's value.
@@ -56,7 +56,7 @@ func LessThanField[T constraints.Ordered](v, other T, field, otherField string)
 	if v >= other {
 		err = fieldErrf(field, "must be less than %s", otherField)
 	}
-	return validation(err, field, "ltfield")
+	return validation(err, field, "ltfield").with("other", otherField)
 }
 
 // GreaterThanOrEqualField validates that a value is greater than or equal to another field's value.
@@ -65,7 +65,7 @@ func GreaterThanOrEqualField[T constraints.Ordered](v, other T, field, otherFiel
 	if v < other {
 		err = fieldErrf(field, "must be greater than or equal to %s", otherField)
 	}
-	return validation(err, field, "gtefield")
+	return validation(err, field, "gtefield").with("other", otherField)
 }
 
 // LessThanOrEqualField validates that a value is less than or equal to another field's value.
@@ -74,5 +74,5 @@ func LessThanOrEqualField[T constraints.Ordered](v, other T, field, otherField s
 	if v > other {
 		err = fieldErrf(field, "must be less than or equal to %s", otherField)
 	}
-	return validation(err, field, "ltefield")
+	return validation(err, field, "ltefield").with("other", otherField)
 }
@@ -0,0 +1,141 @@
+package check
+
+import "strings"
+
+// FieldRef names another field and whether it is set, for [RequiredWith] and
+// related validators whose condition depends on several fields of mixed types.
+type FieldRef struct {
+	Name string
+	Set  bool
+}
+
+// Ref creates a FieldRef that is set when v is not its zero value:
+// a non-blank string, a non-nil pointer, a non-zero number, and so on.
+func Ref[T comparable](v T, name string) FieldRef {
+	return FieldRef{Name: name, Set: !isUnset(v)}
+}
+
+// RequiredIf validates that a value is set when another field equals value.
+// "Set" means not the zero value, so pointers must be non-nil, and strings
+// must not be empty or whitespace-only, as for [Required].
+func RequiredIf[T, U comparable](v T, other, value U, field, otherField string) *Validation {
+	var err error
+	if other == value && isUnset(v) {
+		err = fieldErrf(field, "is required when %s is %v", otherField, value)
+	}
+	return validation(err, field, "required_if").with("other", otherField).with("value", value)
+}
+
+// RequiredUnless validates that a value is set unless another field equals value.
+func RequiredUnless[T, U comparable](v T, other, value U, field, otherField string) *Validation {
+	var err error
+	if other != value && isUnset(v) {
+		err = fieldErrf(field, "is required unless %s is %v", otherField, value)
+	}
+	return validation(err, field, "required_unless").with("other", otherField).with("value", value)
+}
+
+// RequiredWith validates that a value is set when any of the other fields is set.
+//
+// Usage:
+//
+//	check.RequiredWith(r.Country, "country", check.Ref(r.Phone, "phone"))
+func RequiredWith[T comparable](v T, field string, others ...FieldRef) *Validation {
+	set := setRefs(others)
+	var fe *FieldError
+	if len(set) > 0 && isUnset(v) {
+		fe = fieldErrf(field, "is required alongside %s", formatParam(set))
+	}
+	return refsValidation(fe, field, "required_with", others, set)
+}
+
+// RequiredWithAll validates that a value is set when all of the other fields are set.
+func RequiredWithAll[T comparable](v T, field string, others ...FieldRef) *Validation {
+	set := setRefs(others)
+	var fe *FieldError
+	if len(others) > 0 && len(set) == len(others) && isUnset(v) {
+		fe = fieldErrf(field, "is required alongside %s", formatParam(set))
+	}
+	return refsValidation(fe, field, "required_with_all", others, set)
+}
+
+// RequiredWithout validates that a value is set when any of the other fields is not set.
+func RequiredWithout[T comparable](v T, field string, others ...FieldRef) *Validation {
+	unset := unsetRefs(others)
+	var fe *FieldError
+	if len(unset) > 0 && isUnset(v) {
+		fe = fieldErrf(field, "is required without %s", formatParam(unset))
+	}
+	return refsValidation(fe, field, "required_without", others, unset)
+}
+
+// ExcludedIf validates that a value is not set when another field equals value.
+func ExcludedIf[T, U comparable](v T, other, value U, field, otherField string) *Validation {
+	var err error
+	if other == value && !isUnset(v) {
+		err = fieldErrf(field, "must not be set when %s is %v", otherField, value)
+	}
+	return validation(err, field, "excluded_if").with("other", otherField).with("value", value)
+}
+
+// ExcludedWith validates that a value is not set when any of the other fields is set.
+//
+// Usage:
+//
+//	check.ExcludedWith(r.Password, "password", check.Ref(r.SSOToken, "sso_token"))
+func ExcludedWith[T comparable](v T, field string, others ...FieldRef) *Validation {
+	set := setRefs(others)
+	var fe *FieldError
+	if len(set) > 0 && !isUnset(v) {
+		fe = fieldErrf(field, "must not be set alongside %s", formatParam(set))
+	}
+	return refsValidation(fe, field, "excluded_with", others, set)
+}
+
+// isUnset reports whether v is its type's zero value or, like [Required],
+// a string of only whitespace.
+func isUnset[T comparable](v T) bool {
+	if s, ok := any(v).(string); ok {
+		return strings.TrimSpace(s) == ""
+	}
+	var zero T
+	return v == zero
+}
+
+// refsValidation tracks a validation against other fields, recording every
+// declared ref as its "fields" param. A failure names only the refs that
+// triggered it, as its message does.
+func refsValidation(fe *FieldError, field, name string, others []FieldRef, triggering []string) *Validation {
+	names := make([]string, len(others))
+	for i, r := range others {
+		names[i] = r.Name
+	}
+	if fe == nil {
+		return validation(nil, field, name).with("fields", names)
+	}
+	v := validation(fe, field, name).with("fields", names)
+	fe.Params["fields"] = triggering
+	return v
+}
+
+// setRefs returns the names of the refs that are set.
+func setRefs(refs []FieldRef) []string {
+	var names []string
+	for _, r := range refs {
+		if r.Set {
+			names = append(names, r.Name)
+		}
+	}
+	return names
+}
+
+// unsetRefs returns the names of the refs that are not set.
+func unsetRefs(refs []FieldRef) []string {
+	var names []string
+	for _, r := range refs {
+		if !r.Set {
+			names = append(names, r.Name)
+		}
+	}
+	return names
+}
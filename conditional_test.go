@@ -0,0 +1,192 @@
+package check
+
+import "testing"
+
+func TestRef(t *testing.T) {
+	var nilPtr *int
+	tests := []struct {
+		name string
+		ref  FieldRef
+		set  bool
+	}{
+		{"empty string", Ref("", "f"), false},
+		{"string", Ref("x", "f"), true},
+		{"whitespace string", Ref("   ", "f"), false},
+		{"nil pointer", Ref(nilPtr, "f"), false},
+		{"pointer to zero", Ref(Ptr(0), "f"), true},
+		{"zero int", Ref(0, "f"), false},
+		{"int", Ref(3, "f"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.ref.Set != tt.set {
+				t.Errorf("expected Set=%v", tt.set)
+			}
+		})
+	}
+}
+
+func TestRequiredIf(t *testing.T) {
+	t.Run("required when other matches", func(t *testing.T) {
+		v := RequiredIf("", "business", "business", "company", "kind")
+		if v.Error() != "company: is required when kind is business" {
+			t.Errorf("unexpected: %s", v.Error())
+		}
+	})
+
+	t.Run("set value passes", func(t *testing.T) {
+		if v := RequiredIf("Acme", "business", "business", "company", "kind"); v.Failed() {
+			t.Errorf("expected pass, got %v", v.Err())
+		}
+	})
+
+	t.Run("whitespace is not set", func(t *testing.T) {
+		if v := RequiredIf("   ", "business", "business", "company", "kind"); !v.Failed() {
+			t.Error("expected failure for whitespace-only value")
+		}
+		if v := RequiredUnless(" \t", "personal", "business", "vat", "kind"); !v.Failed() {
+			t.Error("expected failure for whitespace-only value")
+		}
+		if v := RequiredWith(" ", "country", Ref("555-0100", "phone")); !v.Failed() {
+			t.Error("expected failure for whitespace-only value")
+		}
+		if v := RequiredWithAll(" ", "country", Ref("555-0100", "phone")); !v.Failed() {
+			t.Error("expected failure for whitespace-only value")
+		}
+		if v := RequiredWithout(" ", "email", Ref("", "phone")); !v.Failed() {
+			t.Error("expected failure for whitespace-only value")
+		}
+	})
+
+	t.Run("other does not match", func(t *testing.T) {
+		if v := RequiredIf("", "personal", "business", "company", "kind"); v.Failed() {
+			t.Errorf("expected pass, got %v", v.Err())
+		}
+	})
+
+	t.Run("pointer", func(t *testing.T) {
+		var vat *string
+		if v := RequiredIf(vat, true, true, "vat", "eu"); !v.Failed() {
+			t.Error("expected failure for nil pointer")
+		}
+		if v := RequiredIf(Ptr(""), true, true, "vat", "eu"); v.Failed() {
+			t.Errorf("expected pass for non-nil pointer, got %v", v.Err())
+		}
+	})
+}
+
+func TestRequiredUnless(t *testing.T) {
+	if v := RequiredUnless("", "personal", "business", "vat", "kind"); !v.Failed() {
+		t.Error("expected failure")
+	}
+	if v := RequiredUnless("", "business", "business", "vat", "kind"); v.Failed() {
+		t.Errorf("expected pass, got %v", v.Err())
+	}
+}
+
+func TestRequiredWith(t *testing.T) {
+	t.Run("required when any other is set", func(t *testing.T) {
+		v := RequiredWith("", "country", Ref("", "email"), Ref("555-0100", "phone"))
+		if v.Error() != "country: is required alongside phone" {
+			t.Errorf("unexpected: %s", v.Error())
+		}
+	})
+
+	t.Run("not required when none are set", func(t *testing.T) {
+		if v := RequiredWith("", "country", Ref("", "phone")); v.Failed() {
+			t.Errorf("expected pass, got %v", v.Err())
+		}
+	})
+
+	t.Run("all", func(t *testing.T) {
+		if v := RequiredWithAll("", "zip", Ref("Main St", "street"), Ref("", "city")); v.Failed() {
+			t.Errorf("expected pass when not all are set, got %v", v.Err())
+		}
+		v := RequiredWithAll("", "zip", Ref("Main St", "street"), Ref("Springfield", "city"))
+		if v.Error() != "zip: is required alongside street, city" {
+			t.Errorf("unexpected: %s", v.Error())
+		}
+		if v := RequiredWithAll("", "zip"); v.Failed() {
+			t.Errorf("expected pass with no others, got %v", v.Err())
+		}
+	})
+
+	t.Run("without", func(t *testing.T) {
+		v := RequiredWithout("", "email", Ref("", "phone"))
+		if v.Error() != "email: is required without phone" {
+			t.Errorf("unexpected: %s", v.Error())
+		}
+		if v := RequiredWithout("", "email", Ref("555-0100", "phone")); v.Failed() {
+			t.Errorf("expected pass, got %v", v.Err())
+		}
+	})
+}
+
+func TestConditionalFieldsParam(t *testing.T) {
+	refs := []FieldRef{Ref("", "email"), Ref("555-0100", "phone")}
+	tests := []struct {
+		name string
+		v    *Validation
+	}{
+		{"required_with", RequiredWith("", "country", refs...)},
+		{"required_with_all", RequiredWithAll("", "country", refs...)},
+		{"required_without", RequiredWithout("", "country", refs...)},
+		{"excluded_with", ExcludedWith("x", "country", refs...)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			applied := All(tt.v).AppliedValidators("country")
+			if len(applied) != 1 || formatParam(applied[0].Params["fields"]) != "email, phone" {
+				t.Errorf("expected every declared field, got %v", applied)
+			}
+			// The message names only the fields that triggered the failure.
+			for _, fe := range GetFieldErrors(All(tt.v)) {
+				if got := fe.Localize(english); got != fe.Message {
+					t.Errorf("localized %q, message %q", got, fe.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestExcluded(t *testing.T) {
+	t.Run("if", func(t *testing.T) {
+		v := ExcludedIf("Acme", "personal", "personal", "company", "kind")
+		if v.Error() != "company: must not be set when kind is personal" {
+			t.Errorf("unexpected: %s", v.Error())
+		}
+		if v := ExcludedIf("", "personal", "personal", "company", "kind"); v.Failed() {
+			t.Errorf("expected pass, got %v", v.Err())
+		}
+	})
+
+	t.Run("with", func(t *testing.T) {
+		v := ExcludedWith("secret", "password", Ref("tok", "sso_token"))
+		if v.Error() != "password: must not be set alongside sso_token" {
+			t.Errorf("unexpected: %s", v.Error())
+		}
+		if v := ExcludedWith("secret", "password", Ref("", "sso_token")); v.Failed() {
+			t.Errorf("expected pass, got %v", v.Err())
+		}
+	})
+}
+
+func TestConditionalCoverage(t *testing.T) {
+	type account struct {
+		Kind    string `json:"kind" validate:"required"`
+		Company string `json:"company" validate:"required_if=Kind business"`
+		Phone   string `json:"phone"`
+		Country string `json:"country" validate:"required_with=Phone"`
+		Token   string `json:"token" validate:"excluded_with=Phone"`
+	}
+	a := account{Kind: "business", Company: "Acme", Phone: "555-0100", Country: "US"}
+	r := Check[account](
+		Str(a.Kind, "kind").Required().V(),
+		RequiredIf(a.Company, a.Kind, "business", "company", "kind"),
+		RequiredWith(a.Country, "country", Ref(a.Phone, "phone")),
+		ExcludedWith(a.Token, "token", Ref(a.Phone, "phone")),
+	)
+	if r.Err() != nil {
+		t.Errorf("expected coverage to be satisfied, got %v", r.Err())
+	}
+}
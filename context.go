@@ -0,0 +1,110 @@
+package check
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// CtxValidator is a validation that needs a context, such as a database
+// uniqueness check or a remote lookup.
+type CtxValidator func(ctx context.Context) *Validation
+
+// Ctx wraps a synchronous validation for use with [AllCtx].
+func Ctx(v *Validation) CtxValidator {
+	return func(context.Context) *Validation { return v }
+}
+
+// Lookup creates a context validator for a field from a predicate that may fail.
+// The validation fails with message when fn reports false. If fn returns an
+// error, the Result carries a [*LookupError] instead of a [*FieldError], since
+// the value could not be judged either way. The validator is tracked as name.
+//
+// Usage:
+//
+//	check.Lookup("username", "unique", func(ctx context.Context) (bool, error) {
+//	    taken, err := users.Exists(ctx, req.Username)
+//	    return !taken, err
+//	}, "is already taken")
+func Lookup(field, name string, fn func(ctx context.Context) (bool, error), message string) CtxValidator {
+	return func(ctx context.Context) *Validation {
+		ok, err := fn(ctx)
+		if err != nil {
+			return validation(&LookupError{Field: field, Validator: name, Err: err}, field, name)
+		}
+		if !ok {
+			return validation(fieldErr(field, message), field, name)
+		}
+		return validation(nil, field, name)
+	}
+}
+
+// LookupError reports a context validator that could not complete.
+type LookupError struct {
+	Field     string
+	Validator string
+	Err       error
+}
+
+func (e *LookupError) Error() string {
+	return e.Field + ": " + e.Validator + " lookup failed: " + e.Err.Error()
+}
+
+func (e *LookupError) Unwrap() error {
+	return e.Err
+}
+
+// AllCtx runs context validators in order and collects their results like [All].
+// Validators are not started once ctx is done; the context's error is then
+// added to the Result, and the skipped validators are not tracked as applied.
+func AllCtx(ctx context.Context, validators ...CtxValidator) *Result {
+	return AllParallel(ctx, 1, validators...)
+}
+
+// AllParallel is like AllCtx but runs up to limit validators concurrently.
+// A limit of zero or less runs all of them at once. Errors and tracking are
+// reported in the order the validators were given, regardless of completion order.
+func AllParallel(ctx context.Context, limit int, validators ...CtxValidator) *Result {
+	if limit <= 0 || limit > len(validators) {
+		limit = len(validators)
+	}
+
+	results := make([]*Validation, len(validators))
+	skipped := false
+
+	sem := make(chan struct{}, max(limit, 1))
+	var wg sync.WaitGroup
+	for i, fn := range validators {
+		if fn == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			skipped = true
+		case sem <- struct{}{}:
+			// Both cases may be ready; never start a validator after cancellation.
+			if ctx.Err() != nil {
+				<-sem
+				skipped = true
+				break
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer func() { <-sem }()
+				results[i] = fn(ctx)
+			}()
+		}
+	}
+	wg.Wait()
+
+	result := All(results...)
+	if skipped {
+		var errs Errors
+		if result.err != nil {
+			errors.As(result.err, &errs)
+		}
+		result.err = append(errs, ctx.Err())
+	}
+	return result
+}
@@ -0,0 +1,204 @@
+package check
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// takenNames is an in-memory stand-in for a uniqueness lookup.
+var takenNames = map[string]bool{"admin": true, "root": true}
+
+func uniqueName(name string) CtxValidator {
+	return Lookup("username", "unique", func(ctx context.Context) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		return !takenNames[name], nil
+	}, "is already taken")
+}
+
+func TestLookup(t *testing.T) {
+	t.Run("passes", func(t *testing.T) {
+		v := uniqueName("alice")(context.Background())
+		if v.Failed() {
+			t.Errorf("unexpected error: %v", v.Err())
+		}
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		v := uniqueName("admin")(context.Background())
+		var fe *FieldError
+		if !errors.As(v.Err(), &fe) || fe.Field != "username" || fe.Message != "is already taken" || fe.Code != "unique" {
+			t.Errorf("unexpected error: %v", v.Err())
+		}
+	})
+
+	t.Run("lookup error", func(t *testing.T) {
+		boom := errors.New("connection refused")
+		v := Lookup("email", "unique", func(context.Context) (bool, error) {
+			return false, boom
+		}, "is taken")(context.Background())
+
+		var le *LookupError
+		if !errors.As(v.Err(), &le) || le.Field != "email" || le.Validator != "unique" {
+			t.Fatalf("expected LookupError, got %v", v.Err())
+		}
+		if !errors.Is(v.Err(), boom) {
+			t.Error("expected to unwrap to the lookup error")
+		}
+		if v.Error() != "email: unique lookup failed: connection refused" {
+			t.Errorf("unexpected message: %s", v.Error())
+		}
+	})
+}
+
+func TestAllCtx(t *testing.T) {
+	t.Run("collects errors and tracking", func(t *testing.T) {
+		r := AllCtx(context.Background(),
+			Ctx(Str("admin", "username").Required().MinLen(3).V()),
+			uniqueName("admin"),
+			nil,
+		)
+		if len(GetFieldErrors(r)) != 1 || !HasField(r, "username") {
+			t.Errorf("unexpected errors: %v", r.Err())
+		}
+		for _, name := range []string{"required", "min", "unique"} {
+			if !r.HasValidator("username", name) {
+				t.Errorf("expected %s to be tracked", name)
+			}
+		}
+	})
+
+	t.Run("passing", func(t *testing.T) {
+		if r := AllCtx(context.Background(), uniqueName("alice")); r.Err() != nil {
+			t.Errorf("unexpected error: %v", r.Err())
+		}
+	})
+
+	t.Run("CheckCtx verifies tags", func(t *testing.T) {
+		type signup struct {
+			Username string `json:"username" validate:"required,unique"`
+			Email    string `json:"email" validate:"required"`
+		}
+		r := CheckCtx[signup](context.Background(),
+			Ctx(Str("alice", "username").Required().V()),
+			uniqueName("alice"),
+		)
+		var ue *UncheckedFieldError
+		if !errors.As(r.Err(), &ue) || ue.Field != "email" {
+			t.Errorf("expected email to be unchecked, got %v", r.Err())
+		}
+		if len(flatten(r.Err())) != 1 {
+			t.Errorf("expected only the unchecked error, got %v", r.Err())
+		}
+	})
+
+	t.Run("cancelled context skips validators", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		var ran atomic.Int32
+		r := AllCtx(ctx,
+			func(context.Context) *Validation {
+				ran.Add(1)
+				cancel()
+				return Required("x", "first")
+			},
+			func(context.Context) *Validation {
+				ran.Add(1)
+				return Required("", "second")
+			},
+		)
+		if ran.Load() != 1 {
+			t.Errorf("expected 1 validator to run, got %d", ran.Load())
+		}
+		if !errors.Is(r.Err(), context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", r.Err())
+		}
+		if HasField(r, "second") || r.HasValidator("second", "required") {
+			t.Error("skipped validator should not be reported or tracked")
+		}
+		if !r.HasValidator("first", "required") {
+			t.Error("expected completed validator to be tracked")
+		}
+	})
+
+	t.Run("deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+		r := AllCtx(ctx, func(ctx context.Context) *Validation {
+			<-ctx.Done()
+			return Lookup("slow", "remote", func(ctx context.Context) (bool, error) {
+				return false, ctx.Err()
+			}, "failed")(ctx)
+		})
+		if !errors.Is(r.Err(), context.DeadlineExceeded) {
+			t.Errorf("expected deadline exceeded, got %v", r.Err())
+		}
+	})
+}
+
+func TestAllParallel(t *testing.T) {
+	t.Run("respects limit", func(t *testing.T) {
+		var running, peak atomic.Int32
+		validator := func(i int) CtxValidator {
+			return func(context.Context) *Validation {
+				n := running.Add(1)
+				for {
+					p := peak.Load()
+					if n <= p || peak.CompareAndSwap(p, n) {
+						break
+					}
+				}
+				time.Sleep(5 * time.Millisecond)
+				running.Add(-1)
+				return Min(i, 5, "n")
+			}
+		}
+		validators := make([]CtxValidator, 10)
+		for i := range validators {
+			validators[i] = validator(i)
+		}
+		r := AllParallel(context.Background(), 3, validators...)
+		if peak.Load() > 3 {
+			t.Errorf("expected at most 3 concurrent validators, got %d", peak.Load())
+		}
+		if len(GetFieldErrors(r)) != 5 {
+			t.Errorf("expected 5 errors, got %d", len(GetFieldErrors(r)))
+		}
+	})
+
+	t.Run("preserves input order", func(t *testing.T) {
+		delayed := func(field string, d time.Duration) CtxValidator {
+			return func(context.Context) *Validation {
+				time.Sleep(d)
+				return Required("", field)
+			}
+		}
+		r := AllParallel(context.Background(), 0,
+			delayed("a", 15*time.Millisecond),
+			delayed("b", 0),
+			delayed("c", 5*time.Millisecond),
+		)
+		names := FieldNames(r)
+		if len(names) != 3 || names[0] != "a" || names[1] != "b" || names[2] != "c" {
+			t.Errorf("expected input order, got %v", names)
+		}
+	})
+
+	t.Run("no validators", func(t *testing.T) {
+		if r := AllParallel(context.Background(), 4); r.Err() != nil {
+			t.Errorf("unexpected error: %v", r.Err())
+		}
+	})
+
+	t.Run("cancelled before start", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		r := AllParallel(ctx, 2, uniqueName("admin"), uniqueName("root"))
+		if !errors.Is(r.Err(), context.Canceled) || HasField(r, "username") {
+			t.Errorf("expected only the cancellation error, got %v", r.Err())
+		}
+	})
+}
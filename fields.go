@@ -0,0 +1,94 @@
+package check
+
+// AtLeastOneOf validates that at least one of the fields is set.
+// If none is, every field fails. Each field is tracked as "atleastoneof".
+//
+// Usage:
+//
+//	check.AtLeastOneOf(check.Ref(r.Email, "email"), check.Ref(r.Phone, "phone"))
+func AtLeastOneOf(refs ...FieldRef) *Validation {
+	var failing []FieldRef
+	if len(setRefs(refs)) == 0 {
+		failing = refs
+	}
+	return fieldGroup(refs, failing, "atleastoneof", "at least one of %s is required")
+}
+
+// ExactlyOneOf validates that exactly one of the fields is set.
+// If none is, every field fails; if several are, the set fields fail.
+// Each field is tracked as "exactlyoneof".
+func ExactlyOneOf(refs ...FieldRef) *Validation {
+	var failing []FieldRef
+	switch len(setRefs(refs)) {
+	case 0:
+		failing = refs
+	case 1:
+	default:
+		failing = setFieldRefs(refs)
+	}
+	return fieldGroup(refs, failing, "exactlyoneof", "exactly one of %s must be set")
+}
+
+// AtMostOneOf validates that no more than one of the fields is set.
+// If several are, the set fields fail. Each field is tracked as "atmostoneof".
+//
+// Usage:
+//
+//	check.AtMostOneOf(check.Ref(r.Password, "password"), check.Ref(r.SSOToken, "sso_token"))
+func AtMostOneOf(refs ...FieldRef) *Validation {
+	var failing []FieldRef
+	if len(setRefs(refs)) > 1 {
+		failing = setFieldRefs(refs)
+	}
+	return fieldGroup(refs, failing, "atmostoneof", "at most one of %s may be set")
+}
+
+// AllOrNone validates that either all of the fields are set or none is.
+// If only some are, the unset fields fail. Each field is tracked as "allornone".
+func AllOrNone(refs ...FieldRef) *Validation {
+	var failing []FieldRef
+	if set := len(setRefs(refs)); set > 0 && set < len(refs) {
+		for _, r := range refs {
+			if !r.Set {
+				failing = append(failing, r)
+			}
+		}
+	}
+	return fieldGroup(refs, failing, "allornone", "all or none of %s must be set")
+}
+
+// fieldGroup builds a validation over several fields, with an error for each
+// failing field and tracking for every field in the group.
+func fieldGroup(refs, failing []FieldRef, name, format string) *Validation {
+	names := make([]string, len(refs))
+	nested := make([]*Validation, len(refs))
+	for i, r := range refs {
+		names[i] = r.Name
+		nested[i] = validation(nil, r.Name, name)
+	}
+
+	var errs Errors
+	for _, r := range failing {
+		fe := fieldErrf(r.Name, format, formatParam(names))
+		errs = append(errs, validation(fe, r.Name, name).with("fields", names).err)
+	}
+
+	var err error
+	if len(errs) == 1 {
+		err = errs[0]
+	} else if len(errs) > 1 {
+		err = errs
+	}
+	return &Validation{err: err, nested: nested}
+}
+
+// setFieldRefs returns the refs that are set.
+func setFieldRefs(refs []FieldRef) []FieldRef {
+	var set []FieldRef
+	for _, r := range refs {
+		if r.Set {
+			set = append(set, r)
+		}
+	}
+	return set
+}
@@ -0,0 +1,91 @@
+package check
+
+import "testing"
+
+func TestFieldGroups(t *testing.T) {
+	contact := func(email, phone string) []FieldRef {
+		return []FieldRef{Ref(email, "email"), Ref(phone, "phone")}
+	}
+
+	t.Run("at least one of", func(t *testing.T) {
+		r := All(AtLeastOneOf(contact("", "")...))
+		if r.Error() != "email: at least one of email, phone is required; phone: at least one of email, phone is required" {
+			t.Errorf("unexpected: %s", r.Error())
+		}
+		if r := All(AtLeastOneOf(contact("a@b.co", "")...)); r.Err() != nil {
+			t.Errorf("expected pass, got %v", r.Err())
+		}
+	})
+
+	t.Run("exactly one of", func(t *testing.T) {
+		if r := All(ExactlyOneOf(contact("", "555-0100")...)); r.Err() != nil {
+			t.Errorf("expected pass, got %v", r.Err())
+		}
+		if names := FieldNames(All(ExactlyOneOf(contact("", "")...))); len(names) != 2 {
+			t.Errorf("expected both fields to fail when none is set, got %v", names)
+		}
+		refs := append(contact("a@b.co", "555-0100"), Ref("", "fax"))
+		if names := FieldNames(All(ExactlyOneOf(refs...))); len(names) != 2 || names[0] != "email" || names[1] != "phone" {
+			t.Errorf("expected the set fields to fail, got %v", names)
+		}
+	})
+
+	t.Run("at most one of", func(t *testing.T) {
+		if r := All(AtMostOneOf(contact("", "")...)); r.Err() != nil {
+			t.Errorf("expected pass, got %v", r.Err())
+		}
+		r := All(AtMostOneOf(contact("a@b.co", "555-0100")...))
+		if len(GetFieldErrors(r)) != 2 {
+			t.Errorf("expected 2 errors, got %v", r.Err())
+		}
+	})
+
+	t.Run("all or none", func(t *testing.T) {
+		address := func(street, city string) []FieldRef {
+			return []FieldRef{Ref(street, "street"), Ref(city, "city")}
+		}
+		for _, refs := range [][]FieldRef{address("", ""), address("Main St", "Springfield")} {
+			if r := All(AllOrNone(refs...)); r.Err() != nil {
+				t.Errorf("expected pass, got %v", r.Err())
+			}
+		}
+		r := All(AllOrNone(address("Main St", "")...))
+		if r.Error() != "city: all or none of street, city must be set" {
+			t.Errorf("unexpected: %s", r.Error())
+		}
+	})
+
+	t.Run("params", func(t *testing.T) {
+		fe := GetFieldErrors(All(AtMostOneOf(contact("a@b.co", "555-0100")...)))[0]
+		if fe.Code != "atmostoneof" || formatParam(fe.Params["fields"]) != "email, phone" {
+			t.Errorf("unexpected code/params: %s %v", fe.Code, fe.Params)
+		}
+	})
+
+	t.Run("tracks every field", func(t *testing.T) {
+		r := All(AtMostOneOf(contact("", "")...))
+		for _, field := range []string{"email", "phone"} {
+			if !r.HasValidator(field, "atmostoneof") {
+				t.Errorf("expected %s to be tracked, got %v", field, r.Applied())
+			}
+		}
+		if len(r.Fields()) != 2 {
+			t.Errorf("expected no group-level field, got %v", r.Fields())
+		}
+	})
+
+	t.Run("satisfies Check coverage", func(t *testing.T) {
+		type contactRequest struct {
+			Email string `json:"email" validate:"omitempty,email"`
+			Phone string `json:"phone" validate:"omitempty"`
+		}
+		c := contactRequest{Email: "a@b.co"}
+		r := Check[contactRequest](
+			ExactlyOneOf(Ref(c.Email, "email"), Ref(c.Phone, "phone")),
+			Str(c.Email, "email").When(c.Email != "", func(b *StrBuilder) { b.Email() }).V(),
+		)
+		if r.Err() != nil {
+			t.Errorf("expected phone to count as validated, got %v", r.Err())
+		}
+	})
+}
@@ -62,10 +62,10 @@ func URLWithScheme(v string, schemes []string, field string) *Validation {
 			}
 		}
 		if !found {
-			err = fieldErrf(field, "must have scheme: %s", strings.Join(schemes, ", "))
+			err = fieldErrf(field, "must have scheme: %s", strings.Join(schemes, ", ")).withKey("url.scheme")
 		}
 	}
-	return validation(err, field, "url")
+	return validation(err, field, "url").with("schemes", schemes)
 }
 
 // HTTPOrHTTPS validates that a string is a valid HTTP or HTTPS URL.
@@ -166,11 +166,11 @@ func HostPort(v, field string) *Validation {
 	case splitErr != nil:
 		err = fieldErr(field, "must be a valid host:port")
 	case host == "":
-		err = fieldErr(field, "host must not be empty")
+		err = fieldErr(field, "host must not be empty").withKey("hostport.host")
 	default:
 		p, parseErr := strconv.Atoi(port)
 		if parseErr != nil || p < 1 || p > 65535 {
-			err = fieldErr(field, "port must be a valid number (1-65535)")
+			err = fieldErr(field, "port must be a valid number (1-65535)").withKey("hostport.port")
 		}
 	}
 	return validation(err, field, "hostport")
@@ -189,7 +189,7 @@ func HexColor(v, field string) *Validation {
 func HexColorFull(v, field string) *Validation {
 	var err error
 	if !hexColorFullRegex.MatchString(v) {
-		err = fieldErr(field, "must be a valid hex color (#RRGGBB)")
+		err = fieldErr(field, "must be a valid hex color (#RRGGBB)").withKey("hexcolor.full")
 	}
 	return validation(err, field, "hexcolor")
 }
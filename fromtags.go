@@ -0,0 +1,576 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zoobzio/check/internal/tagrules"
+	"golang.org/x/exp/constraints"
+)
+
+// FromTags validates v by interpreting its validate tags. It is an opt-in fallback
+// for types without hand-written validation, such as legacy DTOs and admin tooling;
+// prefer explicit validators elsewhere.
+//
+// Each rule is dispatched to the validator of the same name, so messages and
+// tracking match the fluent equivalent: `validate:"required,min=3"` on a string
+// produces the same Result as Str(v, field).Required().MinLen(3).V(). Rules after
+// "dive" apply to each element and are folded under the field, like the builders'
+// Each. Nested structs, pointers to structs, and slices and maps of structs are
+// validated with paths such as "address.zip" and "items[2].sku".
+//
+// A rule that cannot be interpreted, such as an unknown name or a malformed
+// parameter, produces a [TagError] in the Result.
+//
+// Usage:
+//
+//	if err := check.FromTags(req).Err(); err != nil {
+//	    return err
+//	}
+func FromTags[T any](v T) *Result {
+	rv := indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return All()
+	}
+	return All(structValidations(rv, "")...)
+}
+
+// TagError reports a validate tag rule that [FromTags] cannot interpret.
+type TagError struct {
+	Field string // The field name used in validation
+	Rule  string // The rule as written in the tag, e.g. "min=abc"
+	Tag   string // The validate tag value
+	Err   error  // Why the rule could not be applied
+}
+
+func (e *TagError) Error() string {
+	return e.Field + ": cannot apply rule " + e.Rule + " (validate: " + e.Tag + "): " + e.Err.Error()
+}
+
+func (e *TagError) Unwrap() error {
+	return e.Err
+}
+
+// structValidations interprets the tags of each exported field of a struct.
+func structValidations(rv reflect.Value, prefix string) []*Validation {
+	var validations []*Validation
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("validate")
+		if !sf.IsExported() || tag == "-" {
+			continue
+		}
+		field := joinPath(prefix, tagrules.FieldName(sf.Name, sf.Tag.Get("json")))
+		if tag != "" {
+			validations = append(validations, tagValidations(rv, rv.Field(i), field, tag)...)
+		}
+		validations = append(validations, nestedValidations(rv.Field(i), field)...)
+	}
+	return validations
+}
+
+// nestedValidations interprets the tags of structs nested in a field value:
+// the struct itself, or the struct elements of a slice, array or map.
+func nestedValidations(v reflect.Value, field string) []*Validation {
+	v = indirect(v)
+	switch v.Kind() {
+	case reflect.Struct:
+		return structValidations(v, field)
+	case reflect.Slice, reflect.Array:
+		if !holdsStructs(v.Type()) {
+			return nil
+		}
+		var validations []*Validation
+		for i := 0; i < v.Len(); i++ {
+			if elem := indirect(v.Index(i)); elem.IsValid() {
+				validations = append(validations, structValidations(elem, fmt.Sprintf("%s[%d]", field, i))...)
+			}
+		}
+		return validations
+	case reflect.Map:
+		if !holdsStructs(v.Type()) {
+			return nil
+		}
+		var validations []*Validation
+		for _, key := range sortedMapKeys(v) {
+			if elem := indirect(v.MapIndex(key)); elem.IsValid() {
+				validations = append(validations, structValidations(elem, mapField(field, key.Interface()))...)
+			}
+		}
+		return validations
+	}
+	return nil
+}
+
+// tagValidations interprets the rules of a validate tag for a field value.
+// owner is the struct containing the field, for cross-field rules.
+func tagValidations(owner, v reflect.Value, field, tag string) []*Validation {
+	rules, elemTag := tagrules.SplitDive(tag)
+	omitted := slices.ContainsFunc(rules, func(r tagRule) bool { return r.Name == "omitempty" }) && v.IsZero()
+
+	value := indirect(v)
+	var validations []*Validation
+	for _, rule := range rules {
+		var result *Validation
+		var err error
+		switch {
+		case rule.Name == "keys" || rule.Name == "endkeys":
+			err = errors.New("map key rules are not supported")
+		case tagModifiers[rule.Name]:
+			continue
+		case tagrules.Conditional[rule.Name] != "":
+			result, err = conditionalRule(owner, v, rule, field)
+		case rule.Name == "required" && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface):
+			result = notNil(v, field)
+		case !value.IsValid() && v.Kind() == reflect.Pointer:
+			// Like the Opt builders, a nil pointer skips rules on its value
+			// but records them, here by applying them to the zero value.
+			result, err = valueOrFieldRule(owner, reflect.Zero(pointee(v.Type())), rule, field)
+			result, err = declaredValidation(result), nil
+		case !value.IsValid():
+			continue
+		default:
+			result, err = valueOrFieldRule(owner, value, rule, field)
+		}
+		if err != nil {
+			result = validation(&TagError{Field: field, Rule: rule.String(), Tag: tag, Err: err}, field)
+		}
+		if omitted {
+			// Like When, rules skipped for an empty value are still recorded.
+			result = declaredValidation(result)
+		}
+		if result != nil {
+			validations = append(validations, result)
+		}
+	}
+
+	if elemTag != "" && value.IsValid() {
+		if elems := elementValidations(owner, value, field, elemTag); elems != nil {
+			validations = append(validations, elems)
+		}
+	}
+	return validations
+}
+
+// valueOrFieldRule applies a cross-field rule or a rule on the value alone.
+func valueOrFieldRule(owner, v reflect.Value, rule tagRule, field string) (*Validation, error) {
+	if tagrules.CrossField[rule.Name] != "" {
+		return fieldRule(owner, v, rule, field)
+	}
+	return valueRule(v, rule, field)
+}
+
+// declaredValidation returns a passing Validation recording the validators v
+// applied, for rules skipped on an absent value, or nil if v is nil.
+func declaredValidation(v *Validation) *Validation {
+	if v == nil {
+		return nil
+	}
+	v.resolve()
+	return validation(nil, v.field, v.validators...)
+}
+
+// pointee returns the type at the end of a chain of pointers.
+func pointee(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// elementValidations applies a tag to each element of a slice, array or map,
+// combined under the field like the builders' Each.
+func elementValidations(owner, v reflect.Value, field, tag string) *Validation {
+	var validations []*Validation
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			validations = append(validations, tagValidations(owner, v.Index(i), fmt.Sprintf("%s[%d]", field, i), tag)...)
+		}
+	case reflect.Map:
+		for _, key := range sortedMapKeys(v) {
+			validations = append(validations, tagValidations(owner, v.MapIndex(key), mapField(field, key.Interface()), tag)...)
+		}
+	default:
+		err := fmt.Errorf("cannot dive into %s", v.Type())
+		return validation(&TagError{Field: field, Rule: "dive", Tag: tag, Err: err}, field)
+	}
+	return combine(field, validations)
+}
+
+// valueRule applies a rule that depends only on the field's value.
+func valueRule(v reflect.Value, rule tagRule, field string) (*Validation, error) {
+	switch v.Kind() {
+	case reflect.String:
+		return stringRule(v.String(), rule, field)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return numberRule(v.Int(), rule, field, func(s string) (int64, error) { return strconv.ParseInt(s, 10, 64) })
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return numberRule(v.Uint(), rule, field, func(s string) (uint64, error) { return strconv.ParseUint(s, 10, 64) })
+	case reflect.Float32, reflect.Float64:
+		return numberRule(v.Float(), rule, field, func(s string) (float64, error) { return strconv.ParseFloat(s, 64) })
+	case reflect.Slice, reflect.Array:
+		return sliceRule(v, rule, field)
+	case reflect.Map:
+		return mapRule(v, rule, field)
+	}
+	if rule.Name == "required" {
+		if t, ok := v.Interface().(time.Time); ok {
+			return NotZeroTime(t, field), nil
+		}
+		var err error
+		if v.IsZero() {
+			err = fieldErr(field, "is required")
+		}
+		return validation(err, field, "required"), nil
+	}
+	return nil, unsupported(rule, v.Type())
+}
+
+// stringValidators are string rules that take no parameter.
+var stringValidators = map[string]func(v, field string) *Validation{
+	"required":         Required,
+	"notblank":         NotBlank,
+	"email":            Email,
+	"url":              URL,
+	"http_url":         HTTPOrHTTPS,
+	"uuid":             UUID,
+	"uuid4":            UUID4,
+	"alpha":            Alpha,
+	"alphanum":         AlphaNumeric,
+	"alphaunicode":     AlphaUnicode,
+	"alphanumunicode":  AlphaNumericUnicode,
+	"numeric":          Numeric,
+	"ascii":            ASCII,
+	"printascii":       PrintableASCII,
+	"lowercase":        LowerCase,
+	"uppercase":        UpperCase,
+	"slug":             Slug,
+	"ip":               IP,
+	"ipv4":             IPv4,
+	"ipv6":             IPv6,
+	"cidr":             CIDR,
+	"mac":              MAC,
+	"hostname":         Hostname,
+	"hostname_port":    HostPort,
+	"port":             Port,
+	"hexcolor":         HexColor,
+	"hexadecimal":      Hex,
+	"base64":           Base64,
+	"base64url":        Base64URL,
+	"json":             JSON,
+	"semver":           Semver,
+	"e164":             E164,
+	"credit_card":      CreditCard,
+	"latitude":         Latitude,
+	"longitude":        Longitude,
+	"iso3166_1_alpha2": CountryCode2,
+	"iso3166_1_alpha3": CountryCode3,
+	"iso4217":          CurrencyCode,
+	"datauri":          DataURI,
+	"filepath":         FilePath,
+}
+
+// stringParamValidators are string rules whose parameter is a string.
+var stringParamValidators = map[string]func(v, param, field string) *Validation{
+	"startswith": Prefix,
+	"endswith":   Suffix,
+	"contains":   Contains,
+	"excludes":   NotContains,
+	"eq":         Equal[string],
+	"ne":         NotEqual[string],
+}
+
+// stringLengthValidators are string rules whose parameter is a length.
+var stringLengthValidators = map[string]func(v string, n int, field string) *Validation{
+	"min": MinLen,
+	"max": MaxLen,
+	"len": Len,
+}
+
+// stringRule applies a rule to a string value.
+func stringRule(v string, rule tagRule, field string) (*Validation, error) {
+	if fn, ok := stringValidators[rule.Name]; ok {
+		return fn(v, field), nil
+	}
+	if fn, ok := stringParamValidators[rule.Name]; ok {
+		return fn(v, rule.Param, field), nil
+	}
+	if fn, ok := stringLengthValidators[rule.Name]; ok {
+		n, err := strconv.Atoi(rule.Param)
+		if err != nil {
+			return nil, err
+		}
+		return fn(v, n, field), nil
+	}
+	if rule.Name == "oneof" {
+		return OneOf(v, strings.Fields(rule.Param), field), nil
+	}
+	return nil, unsupported(rule, reflect.TypeFor[string]())
+}
+
+// numberRule applies a rule to a numeric value, parsing parameters with parse.
+func numberRule[T Number](v T, rule tagRule, field string, parse func(string) (T, error)) (*Validation, error) {
+	compare := map[string]func(v, n T, field string) *Validation{
+		"min": Min[T],
+		"max": Max[T],
+		"gt":  GreaterThan[T],
+		"gte": GreaterThanOrEqual[T],
+		"lt":  LessThan[T],
+		"lte": LessThanOrEqual[T],
+		"eq":  Equal[T],
+		"ne":  NotEqual[T],
+	}
+	switch rule.Name {
+	case "required":
+		var err error
+		if v == 0 {
+			err = fieldErr(field, "is required")
+		}
+		return validation(err, field, "required"), nil
+	case "oneof":
+		var allowed []T
+		for _, s := range strings.Fields(rule.Param) {
+			n, err := parse(s)
+			if err != nil {
+				return nil, err
+			}
+			allowed = append(allowed, n)
+		}
+		return OneOfValues(v, allowed, field), nil
+	}
+	fn, ok := compare[rule.Name]
+	if !ok {
+		return nil, unsupported(rule, reflect.TypeOf(v))
+	}
+	n, err := parse(rule.Param)
+	if err != nil {
+		return nil, err
+	}
+	return fn(v, n, field), nil
+}
+
+// sliceRule applies a rule to a slice or array value.
+func sliceRule(v reflect.Value, rule tagRule, field string) (*Validation, error) {
+	items := make([]any, v.Len())
+	for i := range items {
+		items[i] = v.Index(i).Interface()
+	}
+	switch rule.Name {
+	case "required":
+		return NotEmpty(items, field), nil
+	case "unique":
+		if !v.Type().Elem().Comparable() {
+			return nil, unsupported(rule, v.Type())
+		}
+		return Unique(items, field), nil
+	}
+	counts := map[string]func(v []any, n int, field string) *Validation{
+		"min": MinItems[any],
+		"max": MaxItems[any],
+		"len": ExactItems[any],
+	}
+	return countRule(counts, items, rule, v.Type(), field)
+}
+
+// mapRule applies a rule to a map value.
+func mapRule(v reflect.Value, rule tagRule, field string) (*Validation, error) {
+	entries := make(map[any]any, v.Len())
+	for iter := v.MapRange(); iter.Next(); {
+		entries[iter.Key().Interface()] = iter.Value().Interface()
+	}
+	switch rule.Name {
+	case "required":
+		return NotEmptyMap(entries, field), nil
+	case "unique":
+		if !v.Type().Elem().Comparable() {
+			return nil, unsupported(rule, v.Type())
+		}
+		return UniqueValues(entries, field), nil
+	}
+	counts := map[string]func(v map[any]any, n int, field string) *Validation{
+		"min": MinKeys[any, any],
+		"max": MaxKeys[any, any],
+		"len": ExactKeys[any, any],
+	}
+	return countRule(counts, entries, rule, v.Type(), field)
+}
+
+// countRule applies a rule whose parameter is an item count.
+func countRule[C any](counts map[string]func(v C, n int, field string) *Validation, v C, rule tagRule, t reflect.Type, field string) (*Validation, error) {
+	fn, ok := counts[rule.Name]
+	if !ok {
+		return nil, unsupported(rule, t)
+	}
+	n, err := strconv.Atoi(rule.Param)
+	if err != nil {
+		return nil, err
+	}
+	return fn(v, n, field), nil
+}
+
+// fieldRule applies a rule comparing a value with another field of owner.
+// A nil other field skips the rule.
+func fieldRule(owner, v reflect.Value, rule tagRule, field string) (*Validation, error) {
+	other, otherField, err := lookupField(owner, rule.Param)
+	if err != nil {
+		return nil, err
+	}
+	other = indirect(other)
+	if !other.IsValid() {
+		return nil, nil
+	}
+	switch rule.Name {
+	case "eqfield", "nefield":
+		// Slices, maps and funcs cannot be compared with ==, even inside an interface.
+		if !v.Comparable() || !other.Comparable() {
+			return nil, fmt.Errorf("cannot compare %s with %s", v.Type(), other.Type())
+		}
+		if rule.Name == "eqfield" {
+			return EqualField(v.Interface(), other.Interface(), field, otherField), nil
+		}
+		return NotEqualField(v.Interface(), other.Interface(), field, otherField), nil
+	}
+
+	switch {
+	case v.CanInt() && other.CanInt():
+		return orderedField(v.Int(), other.Int(), rule.Name, field, otherField), nil
+	case v.CanUint() && other.CanUint():
+		return orderedField(v.Uint(), other.Uint(), rule.Name, field, otherField), nil
+	case v.CanFloat() && other.CanFloat():
+		return orderedField(v.Float(), other.Float(), rule.Name, field, otherField), nil
+	case v.Kind() == reflect.String && other.Kind() == reflect.String:
+		return orderedField(v.String(), other.String(), rule.Name, field, otherField), nil
+	}
+	return nil, fmt.Errorf("cannot compare %s with %s", v.Type(), other.Type())
+}
+
+// orderedField applies an ordering rule between two field values.
+func orderedField[T constraints.Ordered](v, other T, name, field, otherField string) *Validation {
+	compare := map[string]func(v, other T, field, otherField string) *Validation{
+		"gtfield":  GreaterThanField[T],
+		"gtefield": GreaterThanOrEqualField[T],
+		"ltfield":  LessThanField[T],
+		"ltefield": LessThanOrEqualField[T],
+	}
+	return compare[name](v, other, field, otherField)
+}
+
+// conditionalRule applies a conditional presence rule. A field is set when it
+// is not its zero value or a blank string, so it is passed to the validators
+// as a bool.
+func conditionalRule(owner, v reflect.Value, rule tagRule, field string) (*Validation, error) {
+	set := valueSet(v)
+	switch rule.Name {
+	case "required_if", "required_unless", "excluded_if":
+		name, value, ok := strings.Cut(rule.Param, " ")
+		if !ok {
+			return nil, errors.New("expected a field name and a value")
+		}
+		other, otherField, err := lookupField(owner, name)
+		if err != nil {
+			return nil, err
+		}
+		var current string
+		if other = indirect(other); other.IsValid() {
+			current = fmt.Sprint(other.Interface())
+		}
+		switch rule.Name {
+		case "required_if":
+			return RequiredIf(set, current, value, field, otherField), nil
+		case "required_unless":
+			return RequiredUnless(set, current, value, field, otherField), nil
+		default:
+			return ExcludedIf(set, current, value, field, otherField), nil
+		}
+	}
+
+	var refs []FieldRef
+	for _, name := range strings.Fields(rule.Param) {
+		other, otherField, err := lookupField(owner, name)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, FieldRef{Name: otherField, Set: valueSet(other)})
+	}
+	switch rule.Name {
+	case "required_with":
+		return RequiredWith(set, field, refs...), nil
+	case "required_with_all":
+		return RequiredWithAll(set, field, refs...), nil
+	case "required_without":
+		return RequiredWithout(set, field, refs...), nil
+	default:
+		return ExcludedWith(set, field, refs...), nil
+	}
+}
+
+// valueSet reports whether v is set for conditional rules, as [Ref] does.
+func valueSet(v reflect.Value) bool {
+	if v.Kind() == reflect.String {
+		return strings.TrimSpace(v.String()) != ""
+	}
+	return !v.IsZero()
+}
+
+// lookupField looks up a field of owner by its Go name, returning its value
+// and its validation name.
+func lookupField(owner reflect.Value, name string) (reflect.Value, string, error) {
+	if owner.Kind() != reflect.Struct {
+		return reflect.Value{}, "", fmt.Errorf("field %s: no enclosing struct", name)
+	}
+	sf, ok := owner.Type().FieldByName(name)
+	if !ok || !sf.IsExported() {
+		return reflect.Value{}, "", fmt.Errorf("no exported field %s in %s", name, owner.Type())
+	}
+	return owner.FieldByIndex(sf.Index), tagrules.FieldName(sf.Name, sf.Tag.Get("json")), nil
+}
+
+// notNil is [NotNil] for a pointer or interface value.
+func notNil(v reflect.Value, field string) *Validation {
+	var p *struct{}
+	if !v.IsNil() {
+		p = &struct{}{}
+	}
+	return NotNil(p, field)
+}
+
+// indirect dereferences pointers and interfaces, returning the zero Value for nil.
+func indirect(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// holdsStructs reports whether a collection type's elements are structs or pointers to structs.
+func holdsStructs(t reflect.Type) bool {
+	elem := t.Elem()
+	if elem.Kind() == reflect.Pointer {
+		elem = elem.Elem()
+	}
+	return elem.Kind() == reflect.Struct
+}
+
+// sortedMapKeys returns the keys of a map value in the order used by the map builders.
+func sortedMapKeys(v reflect.Value) []reflect.Value {
+	keys := v.MapKeys()
+	slices.SortFunc(keys, func(a, b reflect.Value) int {
+		return compareKeys(a.Interface(), b.Interface())
+	})
+	return keys
+}
+
+// unsupported reports a rule that does not apply to a type.
+func unsupported(rule tagRule, t reflect.Type) error {
+	return fmt.Errorf("unsupported rule %s for %s", rule.Name, t)
+}
@@ -0,0 +1,199 @@
+package check
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type tagAddress struct {
+	Street string `json:"street" validate:"required"`
+	Zip    string `json:"zip" validate:"required,len=5,numeric"`
+}
+
+type tagSignup struct {
+	Email    string            `json:"email" validate:"required,email,max=255"`
+	Password string            `json:"password" validate:"required,min=8"`
+	Confirm  string            `json:"confirm" validate:"eqfield=Password"`
+	Age      int               `json:"age" validate:"gte=18,lte=130"`
+	Score    float64           `json:"score" validate:"omitempty,gt=0,lt=1"`
+	Role     string            `json:"role" validate:"omitempty,oneof=admin member"`
+	Website  *string           `json:"website" validate:"omitempty,url"`
+	Tags     []string          `json:"tags" validate:"max=3,dive,min=2"`
+	Labels   map[string]string `json:"labels" validate:"dive,alpha"`
+	Address  tagAddress        `json:"address"`
+	Items    []*tagAddress     `json:"items" validate:"required"`
+	Internal string            `validate:"-"`
+}
+
+// fluent is the hand-written equivalent of tagSignup's tags.
+func (s tagSignup) fluent() *Result {
+	return All(
+		Str(s.Email, "email").Required().Email().MaxLen(255).V(),
+		Str(s.Password, "password").Required().MinLen(8).V(),
+		EqualField(s.Confirm, s.Password, "confirm", "password"),
+		Num(s.Age, "age").GreaterThanOrEqual(18).LessThanOrEqual(130).V(),
+		Num(s.Score, "score").When(s.Score != 0, func(b *NumBuilder[float64]) { b.GreaterThan(0).LessThan(1) }).V(),
+		Str(s.Role, "role").When(s.Role != "", func(b *StrBuilder) { b.OneOf([]string{"admin", "member"}) }).V(),
+		OptStr(s.Website, "website").URL().V(),
+		StrSlice(s.Tags, "tags").MaxItems(3).Each(func(b *StrBuilder) { b.MinLen(2) }).V(),
+		StrMap(s.Labels, "labels").EachValue(func(b *StrBuilder) { b.Alpha() }).V(),
+		Str(s.Address.Street, "address.street").Required().V(),
+		Str(s.Address.Zip, "address.zip").Required().Len(5).Numeric().V(),
+		Slice(s.Items, "items").NotEmpty().V(),
+		Str(s.Items[0].Street, "items[0].street").Required().V(),
+		Str(s.Items[0].Zip, "items[0].zip").Required().Len(5).Numeric().V(),
+	)
+}
+
+func TestFromTags(t *testing.T) {
+	site := "not a url"
+	cases := map[string]tagSignup{
+		"valid": {
+			Email: "a@b.co", Password: "correct-horse", Confirm: "correct-horse", Age: 30,
+			Tags: []string{"go"}, Labels: map[string]string{"env": "prod"},
+			Address: tagAddress{Street: "Main St", Zip: "12345"},
+			Items:   []*tagAddress{{Street: "Elm St", Zip: "54321"}},
+		},
+		"invalid": {
+			Email: "nope", Password: "short", Confirm: "other", Age: 12, Score: 2, Role: "root",
+			Website: &site, Tags: []string{"a", "bb", "c", "dd"}, Labels: map[string]string{"b": "1", "a": "x2"},
+			Address: tagAddress{Zip: "12"},
+			Items:   []*tagAddress{{Zip: "abcde"}},
+		},
+	}
+	for name, s := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, want := FromTags(s), s.fluent()
+			if got.Error() != want.Error() {
+				t.Errorf("errors differ:\n got: %s\nwant: %s", got.Error(), want.Error())
+			}
+			if !reflect.DeepEqual(got.Fields(), want.Fields()) {
+				t.Errorf("fields differ:\n got: %v\nwant: %v", got.Fields(), want.Fields())
+			}
+			if !reflect.DeepEqual(got.Applied(), want.Applied()) {
+				t.Errorf("tracking differs:\n got: %v\nwant: %v", got.Applied(), want.Applied())
+			}
+		})
+	}
+
+	t.Run("pointer and non-struct values", func(t *testing.T) {
+		s := cases["valid"]
+		if r := FromTags(&s); r.Err() != nil {
+			t.Errorf("expected pass, got %v", r.Err())
+		}
+		if r := FromTags[*tagSignup](nil); r.Err() != nil || len(r.Fields()) != 0 {
+			t.Errorf("expected empty result, got %v", r.Err())
+		}
+		if r := FromTags(42); r.Err() != nil {
+			t.Errorf("expected empty result, got %v", r.Err())
+		}
+	})
+}
+
+func TestFromTagsRules(t *testing.T) {
+	t.Run("required pointer", func(t *testing.T) {
+		type req struct {
+			Name *string `json:"name" validate:"required,min=2"`
+		}
+		if r := FromTags(req{}); r.Error() != "name: must not be nil" {
+			t.Errorf("unexpected: %s", r.Error())
+		}
+		if r := FromTags(req{Name: Ptr("a")}); r.Error() != "name: must be at least 2 characters" {
+			t.Errorf("unexpected: %s", r.Error())
+		}
+	})
+
+	t.Run("numbers", func(t *testing.T) {
+		type req struct {
+			Count uint8   `json:"count" validate:"required,max=10"`
+			Kind  int     `json:"kind" validate:"oneof=1 2 3"`
+			Ratio float32 `json:"ratio" validate:"min=0.5"`
+		}
+		r := FromTags(req{Count: 11, Kind: 4, Ratio: 0.25})
+		want := All(
+			Int(uint64(11), "count").NonZero().Max(10).V(),
+			OneOfValues(int64(4), []int64{1, 2, 3}, "kind"),
+			Min(float64(float32(0.25)), 0.5, "ratio"),
+		)
+		if r.Error() != want.Error() {
+			t.Errorf("got %s, want %s", r.Error(), want.Error())
+		}
+	})
+
+	t.Run("cross field", func(t *testing.T) {
+		type req struct {
+			Start int `json:"start"`
+			End   int `json:"end" validate:"gtfield=Start"`
+		}
+		if r := FromTags(req{Start: 5, End: 3}); r.Error() != "end: must be greater than start" {
+			t.Errorf("unexpected: %s", r.Error())
+		}
+	})
+
+	t.Run("conditional", func(t *testing.T) {
+		type req struct {
+			Kind    string `json:"kind"`
+			Company string `json:"company" validate:"required_if=Kind business"`
+			Phone   string `json:"phone"`
+			Country string `json:"country" validate:"required_with=Phone"`
+		}
+		r := FromTags(req{Kind: "business", Company: "  ", Phone: "555-0100", Country: " "})
+		want := All(
+			RequiredIf("", "business", "business", "company", "kind"),
+			RequiredWith("", "country", Ref("555-0100", "phone")),
+		)
+		if r.Error() != want.Error() {
+			t.Errorf("got %s, want %s", r.Error(), want.Error())
+		}
+		if !r.HasValidator("company", "required_if") {
+			t.Errorf("unexpected tracking: %v", r.Applied())
+		}
+	})
+
+	t.Run("tag errors", func(t *testing.T) {
+		type req struct {
+			Name  string `json:"name" validate:"min=abc"`
+			Code  string `json:"code" validate:"unknown"`
+			Count int    `json:"count" validate:"email"`
+			Ref   string `json:"ref" validate:"eqfield=Missing"`
+		}
+		r := FromTags(req{})
+		errs := flatten(r.Err())
+		if len(errs) != 4 {
+			t.Fatalf("expected 4 errors, got %v", r.Err())
+		}
+		for _, err := range errs {
+			var te *TagError
+			if !errors.As(err, &te) {
+				t.Errorf("expected TagError, got %T: %v", err, err)
+			}
+		}
+	})
+	t.Run("uncomparable cross-field values", func(t *testing.T) {
+		type req struct {
+			Tags    []string       `json:"tags" validate:"eqfield=Other"`
+			Other   []string       `json:"other"`
+			Counts  map[string]int `json:"counts" validate:"nefield=Totals"`
+			Totals  map[string]int `json:"totals"`
+			Value   any            `json:"value" validate:"eqfield=Default"`
+			Default any            `json:"default"`
+		}
+		r := FromTags(req{
+			Tags: []string{"a"}, Other: []string{"a"},
+			Counts: map[string]int{"a": 1}, Totals: map[string]int{"a": 1},
+			Value: []string{"a"}, Default: []string{"a"},
+		})
+		var fields []string
+		for _, err := range flatten(r.Err()) {
+			var te *TagError
+			if !errors.As(err, &te) {
+				t.Fatalf("expected TagError, got %T: %v", err, err)
+			}
+			fields = append(fields, te.Field)
+		}
+		if want := []string{"tags", "counts", "value"}; !reflect.DeepEqual(fields, want) {
+			t.Errorf("expected tag errors for %v, got %v", want, r.Err())
+		}
+	})
+}
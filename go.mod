@@ -8,3 +8,9 @@ require (
 	github.com/zoobzio/sentinel v1.0.2
 	golang.org/x/exp v0.0.0-20260112195511-716be5621a96
 )
+
+require (
+	golang.org/x/mod v0.32.0 // indirect
+	golang.org/x/sync v0.19.0 // indirect
+	golang.org/x/tools v0.41.0
+)
@@ -0,0 +1,59 @@
+package check
+
+import "slices"
+
+// validationGroup holds validations scoped to a named group. See [Group].
+type validationGroup struct {
+	name    string
+	members []*Validation
+}
+
+// Group scopes validations to a named validation group, such as "create" or "update".
+// Grouped validations run only when their group is selected with [AllIn] or [CheckIn];
+// [All], [First] and [Check] select no groups and skip them. Builder validations in
+// a group that is not selected are never evaluated. Groups may be nested, in which
+// case every enclosing group must be selected.
+//
+// A group reports nothing on its own: it has no error until run with AllIn or CheckIn.
+//
+// Usage:
+//
+//	func (u *User) Validate(groups ...string) *check.Result {
+//	    return check.CheckIn[User](groups,
+//	        check.Str(u.Email, "email").Required().Email().V(),
+//	        check.Group("create", check.Str(u.ID, "id").MaxLen(0).Msg("must not be set").V()),
+//	        check.Group("update", check.Str(u.ID, "id").Required().UUID().V()),
+//	    )
+//	}
+func Group(name string, validations ...*Validation) *Validation {
+	return &Validation{group: &validationGroup{name: name, members: validations}}
+}
+
+// AllIn is like [All] but also runs the validations in the selected groups.
+// Ungrouped validations always run.
+func AllIn(groups []string, validations ...*Validation) *Result {
+	return All(inGroups(validations, groups)...)
+}
+
+// inGroups expands group validations whose group is selected and drops the rest.
+// The validations are returned as-is if none is grouped.
+func inGroups(validations []*Validation, groups []string) []*Validation {
+	if !slices.ContainsFunc(validations, (*Validation).grouped) {
+		return validations
+	}
+	scoped := make([]*Validation, 0, len(validations))
+	for _, v := range validations {
+		switch {
+		case !v.grouped():
+			scoped = append(scoped, v)
+		case slices.Contains(groups, v.group.name):
+			scoped = append(scoped, inGroups(v.group.members, groups)...)
+		}
+	}
+	return scoped
+}
+
+// grouped reports whether v was created by [Group].
+func (v *Validation) grouped() bool {
+	return v != nil && v.group != nil
+}
@@ -0,0 +1,126 @@
+package check
+
+import (
+	"errors"
+	"testing"
+)
+
+type groupUser struct {
+	ID    string `json:"id" validate:"required,uuid" validate_create:"-"`
+	Email string `json:"email" validate:"required,email"`
+	Role  string `json:"role" validate_admin:"required,oneof"`
+}
+
+func (u groupUser) validate(groups ...string) *Result {
+	return CheckIn[groupUser](groups,
+		Str(u.Email, "email").Required().Email().V(),
+		Group("create", Str(u.ID, "id").MaxLen(0).Msg("must not be set").V()),
+		Group("update", Str(u.ID, "id").Required().UUID().V()),
+		Group("admin", Str(u.Role, "role").Required().OneOf([]string{"admin", "member"}).V()),
+	)
+}
+
+func TestGroup(t *testing.T) {
+	t.Run("skipped by All", func(t *testing.T) {
+		r := All(
+			Str("", "name").Required().V(),
+			Group("create", Str("x", "id").MaxLen(0).Msg("must not be set").V()),
+		)
+		if r.Error() != "name: is required" {
+			t.Errorf("unexpected: %s", r.Error())
+		}
+		if len(r.Fields()) != 1 {
+			t.Errorf("expected grouped field not to be tracked, got %v", r.Fields())
+		}
+	})
+
+	t.Run("skipped by First", func(t *testing.T) {
+		r := First(Group("create", Str("x", "id").MaxLen(0).Msg("must not be set").V()), Str("ok", "name").Required().V())
+		if r.Err() != nil || r.HasValidator("id", "max") {
+			t.Errorf("unexpected: %v %v", r.Err(), r.Applied())
+		}
+	})
+
+	t.Run("selected by AllIn", func(t *testing.T) {
+		vs := []*Validation{
+			Group("create", Str("x", "id").MaxLen(0).Msg("must not be set").V()),
+			Group("update", Str("x", "id").UUID().V()),
+		}
+		r := AllIn([]string{"create"}, vs...)
+		if r.Error() != "id: must not be set" {
+			t.Errorf("unexpected: %s", r.Error())
+		}
+		if r.HasValidator("id", "uuid") {
+			t.Error("expected unselected group not to be tracked")
+		}
+		if r := AllIn([]string{"create", "update"}, vs...); len(GetFieldErrors(r)) != 2 {
+			t.Errorf("expected both groups to run, got %v", r.Err())
+		}
+	})
+
+	t.Run("nested groups require every group", func(t *testing.T) {
+		v := Group("admin", Group("create", Str("", "role").Required().V()))
+		if r := AllIn([]string{"create"}, v); r.Err() != nil {
+			t.Errorf("expected pass, got %v", r.Err())
+		}
+		if r := AllIn([]string{"admin", "create"}, v); r.Error() != "role: is required" {
+			t.Errorf("unexpected: %s", r.Error())
+		}
+	})
+
+	t.Run("unselected builders are not evaluated", func(t *testing.T) {
+		calls := 0
+		_ = AllIn([]string{"update"}, Group("create", Str("x", "id").Check("counted", func(string) bool {
+			calls++
+			return true
+		}, "fails").V()))
+		if calls != 0 {
+			t.Errorf("expected no calls, got %d", calls)
+		}
+	})
+
+	t.Run("reports nothing on its own", func(t *testing.T) {
+		if v := Group("create", Required("", "id")); v.Failed() {
+			t.Error("expected no error from an unrun group")
+		}
+	})
+}
+
+func TestCheckIn(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		if r := (groupUser{Email: "a@b.co"}).validate("create"); r.Err() != nil {
+			t.Errorf("expected pass, got %v", r.Err())
+		}
+		if r := (groupUser{ID: "x", Email: "a@b.co"}).validate("create"); r.Error() != "id: must not be set" {
+			t.Errorf("unexpected: %s", r.Error())
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		u := groupUser{ID: "550e8400-e29b-41d4-a716-446655440000", Email: "a@b.co"}
+		if r := u.validate("update"); r.Err() != nil {
+			t.Errorf("expected pass, got %v", r.Err())
+		}
+	})
+
+	t.Run("base tag applies without a group tag", func(t *testing.T) {
+		u := groupUser{ID: "550e8400-e29b-41d4-a716-446655440000", Email: "a@b.co"}
+		r := CheckIn[groupUser](nil, Str(u.Email, "email").Required().Email().V())
+		var unchecked *UncheckedFieldError
+		if errs := flatten(r.Err()); len(errs) != 1 || !errors.As(errs[0], &unchecked) || unchecked.Field != "id" {
+			t.Errorf("expected id to be unchecked, got %v", r.Err())
+		}
+	})
+
+	t.Run("group tag replaces the base tag", func(t *testing.T) {
+		u := groupUser{ID: "550e8400-e29b-41d4-a716-446655440000", Email: "a@b.co", Role: "admin"}
+		if r := u.validate("update", "admin"); r.Err() != nil {
+			t.Errorf("expected pass, got %v", r.Err())
+		}
+		r := CheckIn[groupUser]([]string{"admin"}, Str(u.Email, "email").Required().Email().V(), Str(u.ID, "id").Required().UUID().V())
+		var unchecked *UncheckedFieldError
+		if errs := flatten(r.Err()); len(errs) != 1 || !errors.As(errs[0], &unchecked) || unchecked.Tag != "required,oneof" {
+			t.Errorf("expected role to be checked against its admin tag, got %v", r.Err())
+		}
+	})
+}
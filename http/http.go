@@ -0,0 +1,209 @@
+// Package http decodes and validates JSON request bodies for net/http handlers.
+//
+// [Decode] reads a request body into a value and runs its Validate method.
+// [Handle] wraps a handler so that malformed bodies are rejected with 400 and
+// invalid ones with 422, written as RFC 9457 problem+json:
+//
+//	mux.Handle("POST /users", checkhttp.Handle(func(w http.ResponseWriter, r *http.Request, req CreateUser) {
+//	    // req is decoded and valid
+//	}))
+//
+// Import it under a name that does not clash with net/http, e.g. checkhttp.
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+
+	"github.com/zoobzio/check"
+)
+
+// DefaultMaxBodyBytes is the body size limit used when none is configured.
+const DefaultMaxBodyBytes int64 = 1 << 20
+
+// ErrorWriter writes the response for a request that failed decoding or validation.
+// err is a [*DecodeError] or a [*ValidationError].
+type ErrorWriter func(w http.ResponseWriter, r *http.Request, err error)
+
+// Option configures Decode and Handle.
+type Option func(*options)
+
+type options struct {
+	maxBodyBytes          int64
+	disallowUnknownFields bool
+	errorWriter           ErrorWriter
+}
+
+// MaxBodyBytes limits the size of the request body. Larger bodies fail with 413.
+// Zero or less removes the limit.
+func MaxBodyBytes(n int64) Option {
+	return func(o *options) { o.maxBodyBytes = n }
+}
+
+// DisallowUnknownFields rejects bodies containing fields that do not exist in the target type.
+func DisallowUnknownFields() Option {
+	return func(o *options) { o.disallowUnknownFields = true }
+}
+
+// WithErrorWriter replaces the default problem+json error response used by Handle.
+func WithErrorWriter(fn ErrorWriter) Option {
+	return func(o *options) { o.errorWriter = fn }
+}
+
+func buildOptions(opts []Option) options {
+	o := options{maxBodyBytes: DefaultMaxBodyBytes, errorWriter: WriteError}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// DecodeError reports a request body that could not be decoded.
+type DecodeError struct {
+	Status int   // HTTP status for the response: 400, or 413 for oversized bodies
+	Err    error // Underlying error
+}
+
+func (e *DecodeError) Error() string {
+	return "decoding request body: " + e.Err.Error()
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationError reports a decoded body that failed validation.
+type ValidationError struct {
+	Result *check.Result
+}
+
+func (e *ValidationError) Error() string {
+	return e.Result.Error()
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Result.Err()
+}
+
+// Decode reads a JSON request body into a T and validates it.
+// If T or *T implements [check.Validator], its Validate method is run; types
+// that validate with [check.Check] do so from there. When T is a pointer type,
+// a null body fails with a [*DecodeError], whether or not T has a Validate
+// method. Types without a Validate method are otherwise only decoded.
+//
+// Decode returns a [*DecodeError] if the body is malformed, too large, or contains
+// more than one JSON value, and a [*ValidationError] if validation fails.
+func Decode[T any](r *http.Request, opts ...Option) (T, error) {
+	return decode[T](r, buildOptions(opts))
+}
+
+// decode implements Decode with options already built.
+func decode[T any](r *http.Request, o options) (T, error) {
+	var v T
+
+	if r.Body == nil {
+		return v, &DecodeError{Status: http.StatusBadRequest, Err: errors.New("empty body")}
+	}
+	body := io.Reader(r.Body)
+	if o.maxBodyBytes > 0 {
+		body = http.MaxBytesReader(nil, r.Body, o.maxBodyBytes)
+	}
+
+	dec := json.NewDecoder(body)
+	if o.disallowUnknownFields {
+		dec.DisallowUnknownFields()
+	}
+	if err := dec.Decode(&v); err != nil {
+		return v, decodeError(err)
+	}
+	if err := dec.Decode(&struct{}{}); !errors.Is(err, io.EOF) {
+		if err == nil {
+			err = errors.New("body must contain a single JSON value")
+		}
+		return v, decodeError(err)
+	}
+
+	if rv := reflect.ValueOf(&v).Elem(); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		// A null body leaves a pointer T nil, with nothing to use or validate.
+		return v, decodeError(errors.New("body must not be null"))
+	}
+
+	validator, ok := any(v).(check.Validator)
+	if !ok {
+		validator, ok = any(&v).(check.Validator)
+	}
+	if ok {
+		if result := validator.Validate(); result.Err() != nil {
+			return v, &ValidationError{Result: result}
+		}
+	}
+	return v, nil
+}
+
+// decodeError wraps a decoding failure with the status it should produce.
+func decodeError(err error) *DecodeError {
+	if errors.Is(err, io.EOF) {
+		err = errors.New("empty body")
+	}
+	var maxErr *http.MaxBytesError
+	if errors.As(err, &maxErr) {
+		return &DecodeError{Status: http.StatusRequestEntityTooLarge, Err: err}
+	}
+	return &DecodeError{Status: http.StatusBadRequest, Err: err}
+}
+
+// Handle returns a handler that decodes and validates the request body before calling fn.
+// Failures are written with the configured [ErrorWriter] and fn is not called.
+func Handle[T any](fn func(w http.ResponseWriter, r *http.Request, v T), opts ...Option) http.Handler {
+	o := buildOptions(opts)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v, err := decode[T](r, o)
+		if err != nil {
+			o.errorWriter(w, r, err)
+			return
+		}
+		fn(w, r, v)
+	})
+}
+
+// WriteError is the default ErrorWriter. It writes a problem+json document:
+// 422 with an entry per field for a [*ValidationError], and the decode status
+// with the error as detail for a [*DecodeError]. Other errors produce 500.
+func WriteError(w http.ResponseWriter, r *http.Request, err error) {
+	var problem *check.Problem
+
+	var validationErr *ValidationError
+	var decodeErr *DecodeError
+	switch {
+	case errors.As(err, &validationErr):
+		problem = check.NewProblem(validationErr.Result)
+	case errors.As(err, &decodeErr):
+		problem = statusProblem(decodeErr.Status, decodeErr.Err.Error())
+	default:
+		problem = statusProblem(http.StatusInternalServerError, "")
+	}
+	if problem == nil {
+		// A ValidationError wrapping a passing Result is not a client error.
+		problem = statusProblem(http.StatusInternalServerError, "")
+	}
+	problem.Instance = r.URL.Path
+
+	w.Header().Set("Content-Type", check.ProblemContentType)
+	w.WriteHeader(problem.Status)
+	// Headers are already sent, so an encoding failure cannot be reported.
+	_ = json.NewEncoder(w).Encode(problem)
+}
+
+// statusProblem builds a problem document with no field errors.
+func statusProblem(status int, detail string) *check.Problem {
+	return &check.Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+		Errors: []check.ProblemError{},
+	}
+}
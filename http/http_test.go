@@ -0,0 +1,235 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/zoobzio/check"
+)
+
+type createUser struct {
+	Email string `json:"email" validate:"required,email"`
+	Name  string `json:"name" validate:"required"`
+}
+
+func (u createUser) Validate() *check.Result {
+	return check.Check[createUser](
+		check.Str(u.Email, "email").Required().Email().V(),
+		check.Str(u.Name, "name").Required().V(),
+	)
+}
+
+type pointerUser struct {
+	Name string `json:"name"`
+}
+
+func (u *pointerUser) Validate() *check.Result {
+	return check.All(check.Str(u.Name, "name").Required().V())
+}
+
+type plain struct {
+	Name string `json:"name"`
+}
+
+func newRequest(body string) *http.Request {
+	return httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(body))
+}
+
+func TestDecode(t *testing.T) {
+	t.Run("valid body", func(t *testing.T) {
+		u, err := Decode[createUser](newRequest(`{"email":"a@b.co","name":"Ann"}`))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if u.Email != "a@b.co" || u.Name != "Ann" {
+			t.Errorf("unexpected value: %+v", u)
+		}
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		_, err := Decode[createUser](newRequest(`{"email":"nope","name":""}`))
+		var ve *ValidationError
+		if !errors.As(err, &ve) {
+			t.Fatalf("expected ValidationError, got %v", err)
+		}
+		if !check.HasField(ve.Result, "email") || !check.HasField(ve.Result, "name") {
+			t.Errorf("unexpected result: %v", ve.Result)
+		}
+	})
+
+	t.Run("pointer receiver", func(t *testing.T) {
+		_, err := Decode[pointerUser](newRequest(`{}`))
+		var ve *ValidationError
+		if !errors.As(err, &ve) {
+			t.Fatalf("expected ValidationError, got %v", err)
+		}
+	})
+
+	t.Run("pointer type", func(t *testing.T) {
+		_, err := Decode[*createUser](newRequest(`{"email":"nope","name":""}`))
+		var ve *ValidationError
+		if !errors.As(err, &ve) {
+			t.Fatalf("expected ValidationError, got %v", err)
+		}
+
+		u, err := Decode[*pointerUser](newRequest(`{"name":"Ann"}`))
+		if err != nil || u.Name != "Ann" {
+			t.Errorf("unexpected: %+v %v", u, err)
+		}
+	})
+
+	t.Run("pointer type with null body", func(t *testing.T) {
+		_, err := Decode[*pointerUser](newRequest(`null`))
+		var de *DecodeError
+		if !errors.As(err, &de) || de.Status != http.StatusBadRequest {
+			t.Fatalf("expected 400 DecodeError, got %v", err)
+		}
+
+		// Types without a Validate method reject a null body too.
+		_, err = Decode[*plain](newRequest(`null`))
+		if !errors.As(err, &de) || de.Status != http.StatusBadRequest {
+			t.Fatalf("expected 400 DecodeError for a non-validator, got %v", err)
+		}
+	})
+
+	t.Run("no validator", func(t *testing.T) {
+		p, err := Decode[plain](newRequest(`{"name":""}`))
+		if err != nil || p.Name != "" {
+			t.Errorf("unexpected: %+v %v", p, err)
+		}
+	})
+
+	decodeTests := []struct {
+		name   string
+		body   string
+		opts   []Option
+		status int
+	}{
+		{"malformed", `{"email":`, nil, http.StatusBadRequest},
+		{"empty", ``, nil, http.StatusBadRequest},
+		{"wrong type", `{"name":1}`, nil, http.StatusBadRequest},
+		{"trailing value", `{"name":"a"} {"name":"b"}`, nil, http.StatusBadRequest},
+		{"unknown field", `{"name":"a","extra":1}`, []Option{DisallowUnknownFields()}, http.StatusBadRequest},
+		{"too large", `{"name":"` + strings.Repeat("a", 100) + `"}`, []Option{MaxBodyBytes(32)}, http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range decodeTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Decode[plain](newRequest(tt.body), tt.opts...)
+			var de *DecodeError
+			if !errors.As(err, &de) {
+				t.Fatalf("expected DecodeError, got %v", err)
+			}
+			if de.Status != tt.status {
+				t.Errorf("expected status %d, got %d", tt.status, de.Status)
+			}
+		})
+	}
+
+	t.Run("unknown fields allowed by default", func(t *testing.T) {
+		if _, err := Decode[plain](newRequest(`{"name":"a","extra":1}`)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("no limit", func(t *testing.T) {
+		body := `{"name":"` + strings.Repeat("a", 100) + `"}`
+		if _, err := Decode[plain](newRequest(body), MaxBodyBytes(32), MaxBodyBytes(0)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestHandle(t *testing.T) {
+	called := false
+	h := Handle(func(w http.ResponseWriter, _ *http.Request, u createUser) {
+		called = true
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		called = false
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, newRequest(`{"email":"a@b.co","name":"Ann"}`))
+		if !called || rec.Code != http.StatusCreated {
+			t.Errorf("expected handler to run, got %d", rec.Code)
+		}
+	})
+
+	t.Run("validation failure", func(t *testing.T) {
+		called = false
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, newRequest(`{"email":"nope","name":"Ann"}`))
+		if called {
+			t.Error("handler should not run")
+		}
+		if rec.Code != http.StatusUnprocessableEntity {
+			t.Errorf("expected 422, got %d", rec.Code)
+		}
+		if ct := rec.Header().Get("Content-Type"); ct != check.ProblemContentType {
+			t.Errorf("unexpected content type: %s", ct)
+		}
+		want := `{"type":"about:blank","title":"Unprocessable Entity","status":422,"detail":"1 validation error",` +
+			`"instance":"/users","errors":[{"pointer":"/email","field":"email","code":"email","message":"must be a valid email address"}]}` + "\n"
+		if rec.Body.String() != want {
+			t.Errorf("unexpected body:\n got %s\nwant %s", rec.Body.String(), want)
+		}
+	})
+
+	t.Run("decode failure", func(t *testing.T) {
+		called = false
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, newRequest(`not json`))
+		if called {
+			t.Error("handler should not run")
+		}
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("expected 400, got %d", rec.Code)
+		}
+		var p check.Problem
+		if err := json.Unmarshal(rec.Body.Bytes(), &p); err != nil {
+			t.Fatalf("invalid problem: %v", err)
+		}
+		if p.Title != "Bad Request" || p.Status != 400 || p.Detail == "" || len(p.Errors) != 0 {
+			t.Errorf("unexpected problem: %+v", p)
+		}
+	})
+
+	t.Run("options built once", func(t *testing.T) {
+		built := 0
+		h := Handle(func(http.ResponseWriter, *http.Request, createUser) {}, func(*options) { built++ })
+		for range 2 {
+			h.ServeHTTP(httptest.NewRecorder(), newRequest(`{"email":"a@b.co","name":"Ann"}`))
+		}
+		if built != 1 {
+			t.Errorf("expected options to be built once, got %d", built)
+		}
+	})
+
+	t.Run("custom error writer", func(t *testing.T) {
+		var got error
+		h := Handle(func(http.ResponseWriter, *http.Request, createUser) {
+			t.Error("handler should not run")
+		}, WithErrorWriter(func(w http.ResponseWriter, _ *http.Request, err error) {
+			got = err
+			w.WriteHeader(http.StatusTeapot)
+		}))
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, newRequest(`{}`))
+		var ve *ValidationError
+		if !errors.As(got, &ve) || rec.Code != http.StatusTeapot {
+			t.Errorf("expected custom writer with ValidationError, got %v %d", got, rec.Code)
+		}
+	})
+}
+
+func TestWriteError(t *testing.T) {
+	rec := httptest.NewRecorder()
+	WriteError(rec, newRequest(""), errors.New("boom"))
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("expected 500, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,198 @@
+package tagrules
+
+// CrossField maps the rules that compare a field with another field of the
+// same struct to the check functions implementing them.
+var CrossField = map[string]string{
+	"eqfield":  "EqualField",
+	"nefield":  "NotEqualField",
+	"gtfield":  "GreaterThanField",
+	"gtefield": "GreaterThanOrEqualField",
+	"ltfield":  "LessThanField",
+	"ltefield": "LessThanOrEqualField",
+}
+
+// Conditional maps the rules whose presence requirement depends on other
+// fields to the check functions implementing them.
+var Conditional = map[string]string{
+	"required_if":       "RequiredIf",
+	"required_unless":   "RequiredUnless",
+	"required_with":     "RequiredWith",
+	"required_with_all": "RequiredWithAll",
+	"required_without":  "RequiredWithout",
+	"excluded_if":       "ExcludedIf",
+	"excluded_with":     "ExcludedWith",
+}
+
+// Calls maps check functions to the validator names they record, as
+// Result.ValidatorsFor reports them. Builder methods share the names of the
+// functions they call. Functions not listed here satisfy no rule.
+var Calls = map[string][]string{
+	"Required":                {"required"},
+	"NotBlank":                {"required"},
+	"MinLen":                  {"min"},
+	"MaxLen":                  {"max"},
+	"Len":                     {"len"},
+	"LenBetween":              {"min", "max"},
+	"Match":                   {"pattern"},
+	"NotMatch":                {"pattern"},
+	"Prefix":                  {"prefix"},
+	"Suffix":                  {"suffix"},
+	"Contains":                {"contains"},
+	"NotContains":             {"excludes"},
+	"OneOf":                   {"oneof"},
+	"NotOneOf":                {"notoneof"},
+	"Alpha":                   {"alpha"},
+	"AlphaNumeric":            {"alphanum"},
+	"Numeric":                 {"numeric"},
+	"AlphaUnicode":            {"alpha"},
+	"AlphaNumericUnicode":     {"alphanum"},
+	"ASCII":                   {"ascii"},
+	"PrintableASCII":          {"ascii"},
+	"LowerCase":               {"lowercase"},
+	"UpperCase":               {"uppercase"},
+	"NoWhitespace":            {"nowhitespace"},
+	"Trimmed":                 {"trimmed"},
+	"SingleLine":              {"singleline"},
+	"Identifier":              {"identifier"},
+	"Slug":                    {"slug"},
+	"Min":                     {"min"},
+	"Max":                     {"max"},
+	"Between":                 {"min", "max"},
+	"BetweenExclusive":        {"gt", "lt"},
+	"Positive":                {"gt"},
+	"Negative":                {"lt"},
+	"NonNegative":             {"gte"},
+	"NonPositive":             {"lte"},
+	"Zero":                    {"eq"},
+	"NonZero":                 {"ne"},
+	"MultipleOf":              {"multipleof"},
+	"Even":                    {"even"},
+	"Odd":                     {"odd"},
+	"OneOfValues":             {"oneof"},
+	"NotOneOfValues":          {"notoneof"},
+	"GreaterThan":             {"gt"},
+	"LessThan":                {"lt"},
+	"GreaterThanOrEqual":      {"gte"},
+	"LessThanOrEqual":         {"lte"},
+	"Percentage":              {"min", "max"},
+	"PortNumber":              {"port"},
+	"HTTPStatusCode":          {"httpstatus"},
+	"Email":                   {"email"},
+	"URL":                     {"url"},
+	"URLWithScheme":           {"url"},
+	"HTTPOrHTTPS":             {"url"},
+	"UUID":                    {"uuid"},
+	"UUID4":                   {"uuid4"},
+	"IP":                      {"ip"},
+	"IPv4":                    {"ipv4"},
+	"IPv6":                    {"ipv6"},
+	"CIDR":                    {"cidr"},
+	"MAC":                     {"mac"},
+	"Hostname":                {"hostname"},
+	"Port":                    {"port"},
+	"HostPort":                {"hostport"},
+	"HexColor":                {"hexcolor"},
+	"HexColorFull":            {"hexcolor"},
+	"Base64":                  {"base64"},
+	"Base64URL":               {"base64url"},
+	"JSON":                    {"json"},
+	"Semver":                  {"semver"},
+	"E164":                    {"e164"},
+	"CreditCard":              {"creditcard"},
+	"Latitude":                {"latitude"},
+	"Longitude":               {"longitude"},
+	"CountryCode2":            {"iso3166_1_alpha2"},
+	"CountryCode3":            {"iso3166_1_alpha3"},
+	"LanguageCode":            {"iso639_1"},
+	"CurrencyCode":            {"iso4217"},
+	"Hex":                     {"hex"},
+	"DataURI":                 {"datauri"},
+	"FilePath":                {"filepath"},
+	"UnixPath":                {"unixpath"},
+	"NotEmpty":                {"required"},
+	"Empty":                   {"empty"},
+	"MinItems":                {"minitems"},
+	"MaxItems":                {"maxitems"},
+	"ExactItems":              {"len"},
+	"ItemsBetween":            {"minitems", "maxitems"},
+	"Unique":                  {"unique"},
+	"SliceContains":           {"contains"},
+	"SliceNotContains":        {"excludes"},
+	"ContainsAll":             {"containsall"},
+	"ContainsAny":             {"containsany"},
+	"ContainsNone":            {"excludesall"},
+	"AllSatisfy":              {"all"},
+	"AnySatisfies":            {"any"},
+	"NoneSatisfy":             {"none"},
+	"Subset":                  {"subset"},
+	"Disjoint":                {"disjoint"},
+	"NotEmptyMap":             {"required"},
+	"EmptyMap":                {"empty"},
+	"MinKeys":                 {"minkeys"},
+	"MaxKeys":                 {"maxkeys"},
+	"ExactKeys":               {"len"},
+	"KeysBetween":             {"minkeys", "maxkeys"},
+	"HasKey":                  {"haskey"},
+	"HasKeys":                 {"haskeys"},
+	"HasAnyKey":               {"hasanykey"},
+	"NotHasKey":               {"nothaskey"},
+	"NotHasKeys":              {"nothaskeys"},
+	"OnlyKeys":                {"onlykeys"},
+	"UniqueValues":            {"unique"},
+	"NotNil":                  {"required"},
+	"Nil":                     {"nil"},
+	"RequiredPtr":             {"required"},
+	"RequiredPtrField":        {"required"},
+	"NotNilInterface":         {"required"},
+	"Before":                  {"before"},
+	"After":                   {"after"},
+	"BeforeOrEqual":           {"lte"},
+	"AfterOrEqual":            {"gte"},
+	"BeforeNow":               {"past"},
+	"BeforeNowAt":             {"past"},
+	"InPast":                  {"past"},
+	"InPastAt":                {"past"},
+	"AfterNow":                {"future"},
+	"AfterNowAt":              {"future"},
+	"InFuture":                {"future"},
+	"InFutureAt":              {"future"},
+	"BeforeOrEqualNow":        {"pastoreq"},
+	"BeforeOrEqualNowAt":      {"pastoreq"},
+	"AfterOrEqualNow":         {"futureoreq"},
+	"AfterOrEqualNowAt":       {"futureoreq"},
+	"BetweenTime":             {"after", "before"},
+	"BetweenTimeExclusive":    {"gt", "lt"},
+	"WithinDuration":          {"within"},
+	"WithinDurationAt":        {"within"},
+	"WithinDurationOf":        {"within"},
+	"SameDay":                 {"sameday"},
+	"SameMonth":               {"samemonth"},
+	"SameYear":                {"sameyear"},
+	"Weekday":                 {"weekday"},
+	"WeekdayIn":               {"weekday"},
+	"NotWeekend":              {"notweekend"},
+	"IsWeekend":               {"weekend"},
+	"NotZeroTime":             {"required"},
+	"ZeroTime":                {"empty"},
+	"TimeInTimezone":          {"timezone"},
+	"DurationMin":             {"min"},
+	"DurationMax":             {"max"},
+	"DurationBetween":         {"min", "max"},
+	"DurationPositive":        {"gt"},
+	"DurationNonNegative":     {"gte"},
+	"Equal":                   {"eq"},
+	"NotEqual":                {"ne"},
+	"EqualField":              {"eqfield"},
+	"NotEqualField":           {"nefield"},
+	"GreaterThanField":        {"gtfield"},
+	"LessThanField":           {"ltfield"},
+	"GreaterThanOrEqualField": {"gtefield"},
+	"LessThanOrEqualField":    {"ltefield"},
+	"RequiredIf":              {"required_if"},
+	"RequiredUnless":          {"required_unless"},
+	"RequiredWith":            {"required_with"},
+	"RequiredWithAll":         {"required_with_all"},
+	"RequiredWithout":         {"required_without"},
+	"ExcludedIf":              {"excluded_if"},
+	"ExcludedWith":            {"excluded_with"},
+}
@@ -0,0 +1,40 @@
+package tagrules
+
+import "strings"
+
+// FieldName returns the validation name of a struct field: its json name, or
+// its lowercased Go name.
+func FieldName(name, jsonTag string) string {
+	if jsonName := JSONName(jsonTag); jsonName != "" {
+		return jsonName
+	}
+	return strings.ToLower(name)
+}
+
+// JSONName returns the name in a json tag, without options like "omitempty",
+// or "" if the tag has none.
+func JSONName(jsonTag string) string {
+	if jsonTag == "-" {
+		return ""
+	}
+	name, _, _ := strings.Cut(jsonTag, ",")
+	return name
+}
+
+// Normalize strips indexes and map keys from a field path: "items[0].sku"
+// becomes "items.sku".
+func Normalize(path string) string {
+	var b strings.Builder
+	depth := 0
+	for _, r := range path {
+		switch {
+		case r == '[':
+			depth++
+		case r == ']':
+			depth--
+		case depth == 0:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,67 @@
+// Package tagrules holds the validate tag grammar and tables shared by package
+// check, the checkgen command and the checkvet analyzer, so FromTags, the
+// generated code, the runtime coverage check of Check[T] and the static one
+// agree on how a tag is parsed, how a field is named and which validators
+// satisfy a tag rule.
+package tagrules
+
+import (
+	"slices"
+	"strings"
+)
+
+// Modifiers are tag rules that alter how other rules apply
+// rather than corresponding to a validator.
+var Modifiers = map[string]bool{
+	"omitempty": true,
+	"omitnil":   true,
+	"dive":      true,
+	"keys":      true,
+	"endkeys":   true,
+}
+
+// validators maps tag rule names to the validator names that satisfy them.
+// Rules not listed here are satisfied by a validator of the same name.
+var validators = map[string][]string{
+	"min":             {"min", "minitems", "minkeys"},
+	"max":             {"max", "maxitems", "maxkeys"},
+	"gte":             {"gte", "min", "minitems", "minkeys"},
+	"lte":             {"lte", "max", "maxitems", "maxkeys"},
+	"notblank":        {"required"},
+	"uuid":            {"uuid", "uuid4"},
+	"http_url":        {"url"},
+	"startswith":      {"prefix"},
+	"endswith":        {"suffix"},
+	"alphaunicode":    {"alpha"},
+	"alphanumunicode": {"alphanum"},
+	"printascii":      {"ascii"},
+	"hexadecimal":     {"hex"},
+	"hostname_port":   {"hostport"},
+	"credit_card":     {"creditcard"},
+}
+
+// Validators returns the validator names that satisfy a single rule name,
+// e.g. "min", without alternatives or parameter.
+func Validators(rule string) []string {
+	if names, ok := validators[rule]; ok {
+		return names
+	}
+	return []string{rule}
+}
+
+// Satisfied reports whether any of the applied validators satisfies the rule
+// name. Alternatives ("a|b") are satisfied by either side or by the combined
+// name.
+func Satisfied(rule string, applied []string) bool {
+	if slices.Contains(applied, rule) {
+		return true
+	}
+	for _, alt := range strings.Split(rule, "|") {
+		for _, name := range Validators(alt) {
+			if slices.Contains(applied, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
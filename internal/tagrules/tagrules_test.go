@@ -0,0 +1,294 @@
+package tagrules_test
+
+import (
+	"reflect"
+	"regexp"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/zoobzio/check"
+	"github.com/zoobzio/check/internal/tagrules"
+)
+
+func TestParse(t *testing.T) {
+	rules := tagrules.Parse("required, min=8,oneof=a b c,,uuid|slug")
+	expected := []tagrules.Rule{
+		{Name: "required"},
+		{Name: "min", Param: "8"},
+		{Name: "oneof", Param: "a b c"},
+		{Name: "uuid|slug"},
+	}
+	if len(rules) != len(expected) {
+		t.Fatalf("expected %d rules, got %d: %v", len(expected), len(rules), rules)
+	}
+	for i, r := range rules {
+		if r != expected[i] {
+			t.Errorf("rule %d: expected %+v, got %+v", i, expected[i], r)
+		}
+	}
+
+	if tagrules.Parse("-") != nil {
+		t.Error("expected no rules for '-'")
+	}
+	if tagrules.Parse("") != nil {
+		t.Error("expected no rules for empty tag")
+	}
+}
+
+func TestFieldRules(t *testing.T) {
+	rules := tagrules.FieldRules("omitempty,max=10,dive,required,email")
+	if len(rules) != 1 || rules[0].String() != "max=10" {
+		t.Errorf("expected [max=10], got %v", rules)
+	}
+}
+
+func TestSplitDive(t *testing.T) {
+	rules, elemTag := tagrules.SplitDive("omitempty,max=10, dive ,required,dive,email")
+	if len(rules) != 2 || rules[1].String() != "max=10" {
+		t.Errorf("expected [omitempty max=10], got %v", rules)
+	}
+	if elemTag != "required,dive,email" {
+		t.Errorf("expected element tag %q, got %q", "required,dive,email", elemTag)
+	}
+}
+
+func TestGroupTag(t *testing.T) {
+	tag := reflect.StructTag(`validate:"required" validate_create:"required,min=8"`)
+	if got := tagrules.GroupTag(tag, nil); got != "required" {
+		t.Errorf("expected the validate tag, got %q", got)
+	}
+	if got := tagrules.GroupTag(tag, []string{"update", "create"}); got != "required,min=8" {
+		t.Errorf("expected the validate_create tag, got %q", got)
+	}
+}
+
+func TestFieldName(t *testing.T) {
+	tests := []struct {
+		name, jsonTag, want string
+	}{
+		{"Email", "", "email"},
+		{"Email", "email_address", "email_address"},
+		{"Email", "email_address,omitempty", "email_address"},
+		{"Email", ",omitempty", "email"},
+		{"Email", "-", "email"},
+	}
+	for _, tt := range tests {
+		if got := tagrules.FieldName(tt.name, tt.jsonTag); got != tt.want {
+			t.Errorf("FieldName(%q, %q) = %q, want %q", tt.name, tt.jsonTag, got, tt.want)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	if got := tagrules.Normalize("items[0].tags[key].sku"); got != "items.tags.sku" {
+		t.Errorf("expected items.tags.sku, got %q", got)
+	}
+}
+
+// TestCalls checks Calls against the validators the check functions record.
+func TestCalls(t *testing.T) {
+	even := func(n int) bool { return n%2 == 0 }
+	m := map[string]int{}
+	tm := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	g := check.Ref("", "g")
+	calls := map[string]*check.Validation{
+		"Required":                check.Required("", "f"),
+		"NotBlank":                check.NotBlank("", "f"),
+		"MinLen":                  check.MinLen("", 1, "f"),
+		"MaxLen":                  check.MaxLen("", 1, "f"),
+		"Len":                     check.Len("", 1, "f"),
+		"LenBetween":              check.LenBetween("", 1, 2, "f"),
+		"Match":                   check.Match("", regexp.MustCompile("a"), "f"),
+		"NotMatch":                check.NotMatch("", regexp.MustCompile("a"), "f"),
+		"Prefix":                  check.Prefix("", "a", "f"),
+		"Suffix":                  check.Suffix("", "a", "f"),
+		"Contains":                check.Contains("", "a", "f"),
+		"NotContains":             check.NotContains("", "a", "f"),
+		"OneOf":                   check.OneOf("", []string{"a"}, "f"),
+		"NotOneOf":                check.NotOneOf("", []string{"a"}, "f"),
+		"Alpha":                   check.Alpha("", "f"),
+		"AlphaNumeric":            check.AlphaNumeric("", "f"),
+		"Numeric":                 check.Numeric("", "f"),
+		"AlphaUnicode":            check.AlphaUnicode("", "f"),
+		"AlphaNumericUnicode":     check.AlphaNumericUnicode("", "f"),
+		"ASCII":                   check.ASCII("", "f"),
+		"PrintableASCII":          check.PrintableASCII("", "f"),
+		"LowerCase":               check.LowerCase("", "f"),
+		"UpperCase":               check.UpperCase("", "f"),
+		"NoWhitespace":            check.NoWhitespace("", "f"),
+		"Trimmed":                 check.Trimmed("", "f"),
+		"SingleLine":              check.SingleLine("", "f"),
+		"Identifier":              check.Identifier("", "f"),
+		"Slug":                    check.Slug("", "f"),
+		"Min":                     check.Min(0, 1, "f"),
+		"Max":                     check.Max(0, 1, "f"),
+		"Between":                 check.Between(0, 1, 2, "f"),
+		"BetweenExclusive":        check.BetweenExclusive(0, 1, 2, "f"),
+		"Positive":                check.Positive(0, "f"),
+		"Negative":                check.Negative(0, "f"),
+		"NonNegative":             check.NonNegative(0, "f"),
+		"NonPositive":             check.NonPositive(0, "f"),
+		"Zero":                    check.Zero(0, "f"),
+		"NonZero":                 check.NonZero(0, "f"),
+		"MultipleOf":              check.MultipleOf(0, 2, "f"),
+		"Even":                    check.Even(0, "f"),
+		"Odd":                     check.Odd(0, "f"),
+		"OneOfValues":             check.OneOfValues(0, []int{1}, "f"),
+		"NotOneOfValues":          check.NotOneOfValues(0, []int{1}, "f"),
+		"GreaterThan":             check.GreaterThan(0, 1, "f"),
+		"LessThan":                check.LessThan(0, 1, "f"),
+		"GreaterThanOrEqual":      check.GreaterThanOrEqual(0, 1, "f"),
+		"LessThanOrEqual":         check.LessThanOrEqual(0, 1, "f"),
+		"Percentage":              check.Percentage(0, "f"),
+		"PortNumber":              check.PortNumber(0, "f"),
+		"HTTPStatusCode":          check.HTTPStatusCode(0, "f"),
+		"Email":                   check.Email("", "f"),
+		"URL":                     check.URL("", "f"),
+		"URLWithScheme":           check.URLWithScheme("", []string{"https"}, "f"),
+		"HTTPOrHTTPS":             check.HTTPOrHTTPS("", "f"),
+		"UUID":                    check.UUID("", "f"),
+		"UUID4":                   check.UUID4("", "f"),
+		"IP":                      check.IP("", "f"),
+		"IPv4":                    check.IPv4("", "f"),
+		"IPv6":                    check.IPv6("", "f"),
+		"CIDR":                    check.CIDR("", "f"),
+		"MAC":                     check.MAC("", "f"),
+		"Hostname":                check.Hostname("", "f"),
+		"Port":                    check.Port("", "f"),
+		"HostPort":                check.HostPort("", "f"),
+		"HexColor":                check.HexColor("", "f"),
+		"HexColorFull":            check.HexColorFull("", "f"),
+		"Base64":                  check.Base64("", "f"),
+		"Base64URL":               check.Base64URL("", "f"),
+		"JSON":                    check.JSON("", "f"),
+		"Semver":                  check.Semver("", "f"),
+		"E164":                    check.E164("", "f"),
+		"CreditCard":              check.CreditCard("", "f"),
+		"Latitude":                check.Latitude("", "f"),
+		"Longitude":               check.Longitude("", "f"),
+		"CountryCode2":            check.CountryCode2("", "f"),
+		"CountryCode3":            check.CountryCode3("", "f"),
+		"LanguageCode":            check.LanguageCode("", "f"),
+		"CurrencyCode":            check.CurrencyCode("", "f"),
+		"Hex":                     check.Hex("", "f"),
+		"DataURI":                 check.DataURI("", "f"),
+		"FilePath":                check.FilePath("", "f"),
+		"UnixPath":                check.UnixPath("", "f"),
+		"NotEmpty":                check.NotEmpty([]int{}, "f"),
+		"Empty":                   check.Empty([]int{}, "f"),
+		"MinItems":                check.MinItems([]int{}, 1, "f"),
+		"MaxItems":                check.MaxItems([]int{}, 1, "f"),
+		"ExactItems":              check.ExactItems([]int{}, 1, "f"),
+		"ItemsBetween":            check.ItemsBetween([]int{}, 1, 2, "f"),
+		"Unique":                  check.Unique([]int{}, "f"),
+		"SliceContains":           check.SliceContains([]int{}, 1, "f"),
+		"SliceNotContains":        check.SliceNotContains([]int{}, 1, "f"),
+		"ContainsAll":             check.ContainsAll([]int{}, []int{1}, "f"),
+		"ContainsAny":             check.ContainsAny([]int{}, []int{1}, "f"),
+		"ContainsNone":            check.ContainsNone([]int{}, []int{1}, "f"),
+		"AllSatisfy":              check.AllSatisfy([]int{}, even, "f", "must be even"),
+		"AnySatisfies":            check.AnySatisfies([]int{}, even, "f", "must be even"),
+		"NoneSatisfy":             check.NoneSatisfy([]int{}, even, "f", "must be even"),
+		"Subset":                  check.Subset([]int{}, []int{1}, "f"),
+		"Disjoint":                check.Disjoint([]int{}, []int{1}, "f"),
+		"NotEmptyMap":             check.NotEmptyMap(m, "f"),
+		"EmptyMap":                check.EmptyMap(m, "f"),
+		"MinKeys":                 check.MinKeys(m, 1, "f"),
+		"MaxKeys":                 check.MaxKeys(m, 1, "f"),
+		"ExactKeys":               check.ExactKeys(m, 1, "f"),
+		"KeysBetween":             check.KeysBetween(m, 1, 2, "f"),
+		"HasKey":                  check.HasKey(m, "a", "f"),
+		"HasKeys":                 check.HasKeys(m, []string{"a"}, "f"),
+		"HasAnyKey":               check.HasAnyKey(m, []string{"a"}, "f"),
+		"NotHasKey":               check.NotHasKey(m, "a", "f"),
+		"NotHasKeys":              check.NotHasKeys(m, []string{"a"}, "f"),
+		"OnlyKeys":                check.OnlyKeys(m, []string{"a"}, "f"),
+		"UniqueValues":            check.UniqueValues(m, "f"),
+		"NotNil":                  check.NotNil((*int)(nil), "f"),
+		"Nil":                     check.Nil((*int)(nil), "f"),
+		"RequiredPtr":             check.RequiredPtr((*int)(nil), func(int) *check.Validation { return nil }, "f"),
+		"RequiredPtrField":        check.RequiredPtrField((*int)(nil), func(int, string) *check.Validation { return nil }, "f"),
+		"NotNilInterface":         check.NotNilInterface(nil, "f"),
+		"Before":                  check.Before(tm, tm, "f"),
+		"After":                   check.After(tm, tm, "f"),
+		"BeforeOrEqual":           check.BeforeOrEqual(tm, tm, "f"),
+		"AfterOrEqual":            check.AfterOrEqual(tm, tm, "f"),
+		"BeforeNow":               check.BeforeNow(tm, "f"),
+		"BeforeNowAt":             check.BeforeNowAt(tm, tm, "f"),
+		"InPast":                  check.InPast(tm, "f"),
+		"InPastAt":                check.InPastAt(tm, tm, "f"),
+		"AfterNow":                check.AfterNow(tm, "f"),
+		"AfterNowAt":              check.AfterNowAt(tm, tm, "f"),
+		"InFuture":                check.InFuture(tm, "f"),
+		"InFutureAt":              check.InFutureAt(tm, tm, "f"),
+		"BeforeOrEqualNow":        check.BeforeOrEqualNow(tm, "f"),
+		"BeforeOrEqualNowAt":      check.BeforeOrEqualNowAt(tm, tm, "f"),
+		"AfterOrEqualNow":         check.AfterOrEqualNow(tm, "f"),
+		"AfterOrEqualNowAt":       check.AfterOrEqualNowAt(tm, tm, "f"),
+		"BetweenTime":             check.BetweenTime(tm, tm, tm, "f"),
+		"BetweenTimeExclusive":    check.BetweenTimeExclusive(tm, tm, tm, "f"),
+		"WithinDuration":          check.WithinDuration(tm, time.Hour, "f"),
+		"WithinDurationAt":        check.WithinDurationAt(tm, time.Hour, tm, "f"),
+		"WithinDurationOf":        check.WithinDurationOf(tm, time.Hour, tm, "f"),
+		"SameDay":                 check.SameDay(tm, tm, "f"),
+		"SameMonth":               check.SameMonth(tm, tm, "f"),
+		"SameYear":                check.SameYear(tm, tm, "f"),
+		"Weekday":                 check.Weekday(tm, time.Monday, "f"),
+		"WeekdayIn":               check.WeekdayIn(tm, []time.Weekday{time.Monday}, "f"),
+		"NotWeekend":              check.NotWeekend(tm, "f"),
+		"IsWeekend":               check.IsWeekend(tm, "f"),
+		"NotZeroTime":             check.NotZeroTime(tm, "f"),
+		"ZeroTime":                check.ZeroTime(tm, "f"),
+		"TimeInTimezone":          check.TimeInTimezone(tm, time.UTC, "f"),
+		"DurationMin":             check.DurationMin(time.Second, time.Minute, "f"),
+		"DurationMax":             check.DurationMax(time.Second, time.Minute, "f"),
+		"DurationBetween":         check.DurationBetween(time.Second, time.Minute, time.Hour, "f"),
+		"DurationPositive":        check.DurationPositive(time.Second, "f"),
+		"DurationNonNegative":     check.DurationNonNegative(time.Second, "f"),
+		"Equal":                   check.Equal(0, 1, "f"),
+		"NotEqual":                check.NotEqual(0, 1, "f"),
+		"EqualField":              check.EqualField(0, 1, "f", "g"),
+		"NotEqualField":           check.NotEqualField(0, 1, "f", "g"),
+		"GreaterThanField":        check.GreaterThanField(0, 1, "f", "g"),
+		"LessThanField":           check.LessThanField(0, 1, "f", "g"),
+		"GreaterThanOrEqualField": check.GreaterThanOrEqualField(0, 1, "f", "g"),
+		"LessThanOrEqualField":    check.LessThanOrEqualField(0, 1, "f", "g"),
+		"RequiredIf":              check.RequiredIf("", "a", "a", "f", "g"),
+		"RequiredUnless":          check.RequiredUnless("", "a", "b", "f", "g"),
+		"RequiredWith":            check.RequiredWith("", "f", g),
+		"RequiredWithAll":         check.RequiredWithAll("", "f", g),
+		"RequiredWithout":         check.RequiredWithout("", "f", g),
+		"ExcludedIf":              check.ExcludedIf("", "a", "a", "f", "g"),
+		"ExcludedWith":            check.ExcludedWith("", "f", g),
+	}
+
+	for name, want := range tagrules.Calls {
+		v, ok := calls[name]
+		if !ok {
+			t.Errorf("%s: no call in test", name)
+			continue
+		}
+		got := check.All(v).ValidatorsFor("f")
+		if !slices.Equal(got, want) {
+			t.Errorf("%s records %v, Calls has %v", name, got, want)
+		}
+	}
+	for name := range calls {
+		if _, ok := tagrules.Calls[name]; !ok {
+			t.Errorf("%s: missing from Calls", name)
+		}
+	}
+}
+
+// TestRuleFuncs checks that the functions implementing cross-field and
+// conditional rules record the rule they implement.
+func TestRuleFuncs(t *testing.T) {
+	for _, table := range []map[string]string{tagrules.CrossField, tagrules.Conditional} {
+		for rule, fn := range table {
+			if !tagrules.Satisfied(rule, tagrules.Calls[fn]) {
+				t.Errorf("%s: %s records %v", rule, fn, tagrules.Calls[fn])
+			}
+		}
+	}
+}
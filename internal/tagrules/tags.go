@@ -0,0 +1,74 @@
+package tagrules
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Rule is a single rule parsed from a validate tag, e.g. "min=8".
+type Rule struct {
+	Name  string // Rule name, e.g. "min" or "uuid|slug" for alternatives
+	Param string // Raw parameter after "=", empty if none
+}
+
+// String returns the rule as it appeared in the tag.
+func (r Rule) String() string {
+	if r.Param == "" {
+		return r.Name
+	}
+	return r.Name + "=" + r.Param
+}
+
+// Parse splits a validate tag into its rules.
+func Parse(tag string) []Rule {
+	if tag == "" || tag == "-" {
+		return nil
+	}
+	parts := strings.Split(tag, ",")
+	rules := make([]Rule, 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, param, _ := strings.Cut(part, "=")
+		rules = append(rules, Rule{Name: name, Param: param})
+	}
+	return rules
+}
+
+// SplitDive separates the rules for a field from the tag for its elements.
+func SplitDive(tag string) (rules []Rule, elemTag string) {
+	parts := strings.Split(tag, ",")
+	for i, part := range parts {
+		if strings.TrimSpace(part) == "dive" {
+			return Parse(strings.Join(parts[:i], ",")), strings.Join(parts[i+1:], ",")
+		}
+	}
+	return Parse(tag), ""
+}
+
+// FieldRules returns the rules in a tag that apply to the field itself.
+// Modifiers are dropped, and rules after "dive" are excluded since they
+// apply to elements rather than the field.
+func FieldRules(tag string) []Rule {
+	rules, _ := SplitDive(tag)
+	var fieldRules []Rule
+	for _, rule := range rules {
+		if !Modifiers[rule.Name] {
+			fieldRules = append(fieldRules, rule)
+		}
+	}
+	return fieldRules
+}
+
+// GroupTag returns the validate tag of a field for groups: the
+// validate_<group> tag of the first group that has one, or the validate tag.
+func GroupTag(tag reflect.StructTag, groups []string) string {
+	for _, group := range groups {
+		if v, ok := tag.Lookup("validate_" + group); ok {
+			return v
+		}
+	}
+	return tag.Get("validate")
+}
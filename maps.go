@@ -1,10 +1,17 @@
 package check
 
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
 // NotEmptyMap validates that a map is not empty.
 func NotEmptyMap[K comparable, V any](v map[K]V, field string) *Validation {
 	var err error
 	if len(v) == 0 {
-		err = fieldErr(field, "must not be empty")
+		err = fieldErr(field, "must not be empty").withKey("required.empty")
 	}
 	return validation(err, field, "required")
 }
@@ -24,7 +31,7 @@ func MinKeys[K comparable, V any](v map[K]V, minKeys int, field string) *Validat
 	if len(v) < minKeys {
 		err = fieldErrf(field, "must have at least %d keys", minKeys)
 	}
-	return validation(err, field, "minkeys")
+	return validation(err, field, "minkeys").with("min", minKeys)
 }
 
 // MaxKeys validates maximum number of keys in a map.
@@ -33,16 +40,16 @@ func MaxKeys[K comparable, V any](v map[K]V, maxKeys int, field string) *Validat
 	if len(v) > maxKeys {
 		err = fieldErrf(field, "must have at most %d keys", maxKeys)
 	}
-	return validation(err, field, "maxkeys")
+	return validation(err, field, "maxkeys").with("max", maxKeys)
 }
 
 // ExactKeys validates exact number of keys in a map.
 func ExactKeys[K comparable, V any](v map[K]V, count int, field string) *Validation {
 	var err error
 	if len(v) != count {
-		err = fieldErrf(field, "must have exactly %d keys", count)
+		err = fieldErrf(field, "must have exactly %d keys", count).withKey("len.keys")
 	}
-	return validation(err, field, "len")
+	return validation(err, field, "len").with("len", count)
 }
 
 // KeysBetween validates map size is within a range (inclusive).
@@ -50,9 +57,9 @@ func KeysBetween[K comparable, V any](v map[K]V, minKeys, maxKeys int, field str
 	var err error
 	l := len(v)
 	if l < minKeys || l > maxKeys {
-		err = fieldErrf(field, "must have between %d and %d keys", minKeys, maxKeys)
+		err = fieldErrf(field, "must have between %d and %d keys", minKeys, maxKeys).withKey("between.keys").withCode(rangeCode(l > maxKeys, "minkeys", "maxkeys"))
 	}
-	return validation(err, field, "minkeys", "maxkeys")
+	return validation(err, field, "minkeys", "maxkeys").with("min", minKeys).with("max", maxKeys)
 }
 
 // HasKey validates that a map contains the given key.
@@ -61,7 +68,7 @@ func HasKey[K comparable, V any](v map[K]V, key K, field string) *Validation {
 	if _, exists := v[key]; !exists {
 		err = fieldErrf(field, "must contain key %v", key)
 	}
-	return validation(err, field, "haskey")
+	return validation(err, field, "haskey").with("key", key)
 }
 
 // HasKeys validates that a map contains all the given keys.
@@ -73,7 +80,7 @@ func HasKeys[K comparable, V any](v map[K]V, keys []K, field string) *Validation
 			break
 		}
 	}
-	return validation(err, field, "haskeys")
+	return validation(err, field, "haskeys").with("keys", keys)
 }
 
 // HasAnyKey validates that a map contains at least one of the given keys.
@@ -89,7 +96,7 @@ func HasAnyKey[K comparable, V any](v map[K]V, keys []K, field string) *Validati
 	if !found {
 		err = fieldErr(field, "must contain at least one of the required keys")
 	}
-	return validation(err, field, "hasanykey")
+	return validation(err, field, "hasanykey").with("keys", keys)
 }
 
 // NotHasKey validates that a map does not contain the given key.
@@ -98,7 +105,7 @@ func NotHasKey[K comparable, V any](v map[K]V, key K, field string) *Validation
 	if _, exists := v[key]; exists {
 		err = fieldErrf(field, "must not contain key %v", key)
 	}
-	return validation(err, field, "nothaskey")
+	return validation(err, field, "nothaskey").with("key", key)
 }
 
 // NotHasKeys validates that a map does not contain any of the given keys.
@@ -110,7 +117,7 @@ func NotHasKeys[K comparable, V any](v map[K]V, keys []K, field string) *Validat
 			break
 		}
 	}
-	return validation(err, field, "nothaskeys")
+	return validation(err, field, "nothaskeys").with("keys", keys)
 }
 
 // OnlyKeys validates that a map only contains keys from the allowed set.
@@ -126,13 +133,14 @@ func OnlyKeys[K comparable, V any](v map[K]V, allowed []K, field string) *Valida
 			break
 		}
 	}
-	return validation(err, field, "onlykeys")
+	return validation(err, field, "onlykeys").with("allowed", allowed)
 }
 
 // EachKey applies a validation function to each key in a map.
+// Keys are visited in sorted order so the Result is stable between runs.
 func EachKey[K comparable, V any](v map[K]V, fn func(K) *Validation) *Result {
 	validations := make([]*Validation, 0, len(v))
-	for key := range v {
+	for _, key := range sortedKeys(v) {
 		val := fn(key)
 		if val != nil {
 			validations = append(validations, val)
@@ -142,10 +150,11 @@ func EachKey[K comparable, V any](v map[K]V, fn func(K) *Validation) *Result {
 }
 
 // EachMapValue applies a validation function to each value in a map.
+// Values are visited in sorted key order so the Result is stable between runs.
 func EachMapValue[K comparable, V any](v map[K]V, fn func(V) *Validation) *Result {
 	validations := make([]*Validation, 0, len(v))
-	for _, val := range v {
-		result := fn(val)
+	for _, key := range sortedKeys(v) {
+		result := fn(v[key])
 		if result != nil {
 			validations = append(validations, result)
 		}
@@ -154,10 +163,11 @@ func EachMapValue[K comparable, V any](v map[K]V, fn func(V) *Validation) *Resul
 }
 
 // EachEntry applies a validation function to each key-value pair in a map.
+// Entries are visited in sorted key order so the Result is stable between runs.
 func EachEntry[K comparable, V any](v map[K]V, fn func(K, V) *Validation) *Result {
 	validations := make([]*Validation, 0, len(v))
-	for key, val := range v {
-		result := fn(key, val)
+	for _, key := range sortedKeys(v) {
+		result := fn(key, v[key])
 		if result != nil {
 			validations = append(validations, result)
 		}
@@ -167,14 +177,60 @@ func EachEntry[K comparable, V any](v map[K]V, fn func(K, V) *Validation) *Resul
 
 // UniqueValues validates that all values in a map are unique.
 func UniqueValues[K, V comparable](v map[K]V, field string) *Validation {
-	var err error
-	seen := make(map[V]struct{}, len(v))
-	for _, val := range v {
+	return uniqueMapValues(v, field)
+}
+
+// uniqueMapValues is UniqueValues for maps whose value type is not statically
+// comparable. Values that cannot be compared at runtime, such as slices, fail
+// the validation with an error naming their type instead of being compared.
+func uniqueMapValues[K comparable, V any](v map[K]V, field string) *Validation {
+	seen := make(map[any]struct{}, len(v))
+	for _, key := range sortedKeys(v) {
+		val := v[key]
+		if rv := reflect.ValueOf(val); rv.IsValid() && !rv.Comparable() {
+			err := fieldErrf(field, "values of type %s cannot be compared for uniqueness", rv.Type()).withKey("unique.uncomparable")
+			return validation(err, field, "unique").with("type", rv.Type().String())
+		}
 		if _, exists := seen[val]; exists {
-			err = fieldErr(field, "must have unique values")
-			break
+			return validation(fieldErr(field, "must have unique values").withKey("unique.values"), field, "unique")
 		}
 		seen[val] = struct{}{}
 	}
-	return validation(err, field, "unique")
+	return validation(nil, field, "unique")
+}
+
+// mapField returns the field name of a map entry, e.g. "labels[env]".
+func mapField[K comparable](field string, key K) string {
+	return fmt.Sprintf("%s[%v]", field, key)
+}
+
+// sortedKeys returns the keys of a map in a stable order: by value for
+// strings and numbers, by their fmt.Sprint form for other key types.
+func sortedKeys[K comparable, V any](v map[K]V) []K {
+	keys := make([]K, 0, len(v))
+	for key := range v {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, compareKeys[K])
+	return keys
+}
+
+// compareKeys orders two map keys for sortedKeys. Keys of string, integer and
+// float kinds, including named types such as type ID int, are ordered by value.
+func compareKeys[K comparable](a, b K) int {
+	x, y := reflect.ValueOf(a), reflect.ValueOf(b)
+	// Keys of an interface type may hold values of different kinds.
+	if x.Kind() == y.Kind() {
+		switch x.Kind() {
+		case reflect.String:
+			return cmp.Compare(x.String(), y.String())
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return cmp.Compare(x.Int(), y.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return cmp.Compare(x.Uint(), y.Uint())
+		case reflect.Float32, reflect.Float64:
+			return cmp.Compare(x.Float(), y.Float())
+		}
+	}
+	return cmp.Compare(fmt.Sprint(a), fmt.Sprint(b))
 }
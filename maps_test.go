@@ -287,3 +287,76 @@ func TestEachEntry(t *testing.T) {
 		}
 	})
 }
+
+func TestSortedKeys(t *testing.T) {
+	t.Run("strings", func(t *testing.T) {
+		keys := sortedKeys(map[string]int{"b": 0, "c": 0, "a": 0})
+		if len(keys) != 3 || keys[0] != "a" || keys[1] != "b" || keys[2] != "c" {
+			t.Errorf("unexpected order: %v", keys)
+		}
+	})
+
+	t.Run("numbers", func(t *testing.T) {
+		keys := sortedKeys(map[int64]bool{10: true, -1: true, 2: true})
+		if len(keys) != 3 || keys[0] != -1 || keys[1] != 2 || keys[2] != 10 {
+			t.Errorf("unexpected order: %v", keys)
+		}
+		floats := sortedKeys(map[float64]bool{1.5: true, 0.5: true})
+		if floats[0] != 0.5 {
+			t.Errorf("unexpected order: %v", floats)
+		}
+	})
+
+	t.Run("named numbers", func(t *testing.T) {
+		type ID int
+		keys := sortedKeys(map[ID]bool{10: true, 2: true, -1: true})
+		if len(keys) != 3 || keys[0] != -1 || keys[1] != 2 || keys[2] != 10 {
+			t.Errorf("unexpected order: %v", keys)
+		}
+	})
+
+	t.Run("other key types", func(t *testing.T) {
+		type key struct{ a, b int }
+		keys := sortedKeys(map[key]bool{{2, 1}: true, {1, 2}: true})
+		if keys[0] != (key{1, 2}) {
+			t.Errorf("unexpected order: %v", keys)
+		}
+
+		mixed := sortedKeys(map[any]bool{"b": true, 2: true, nil: true})
+		if len(mixed) != 3 || mixed[0] != 2 {
+			t.Errorf("unexpected order: %v", mixed)
+		}
+	})
+}
+
+func TestMapField(t *testing.T) {
+	if got := mapField("labels", "env"); got != "labels[env]" {
+		t.Errorf("unexpected: %s", got)
+	}
+	if got := mapField("ports", 8080); got != "ports[8080]" {
+		t.Errorf("unexpected: %s", got)
+	}
+}
+
+func TestMapIterationOrder(t *testing.T) {
+	m := map[string]int{"delta": 4, "alpha": 1, "charlie": 3, "bravo": 2}
+	want := "alpha: is required; bravo: is required; charlie: is required; delta: is required"
+
+	for i := 0; i < 20; i++ {
+		keys := EachKey(m, func(k string) *Validation { return Required("", k) })
+		if keys.Error() != want {
+			t.Fatalf("EachKey: unexpected order: %s", keys.Error())
+		}
+
+		var seen []int
+		EachMapValue(m, func(v int) *Validation { seen = append(seen, v); return nil })
+		if len(seen) != 4 || seen[0] != 1 || seen[1] != 2 || seen[2] != 3 || seen[3] != 4 {
+			t.Fatalf("EachMapValue: unexpected order: %v", seen)
+		}
+
+		entries := EachEntry(m, func(k string, _ int) *Validation { return Required("", k) })
+		if entries.Error() != want {
+			t.Fatalf("EachEntry: unexpected order: %s", entries.Error())
+		}
+	}
+}
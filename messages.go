@@ -0,0 +1,189 @@
+package check
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"strings"
+)
+
+//go:embed locales/en.json
+var locales embed.FS
+
+// english is the default catalog, matching the messages validators produce.
+var english = mustLoadCatalog(locales, "locales/en.json")
+
+// MessageCatalog resolves message templates by key.
+// Keys are [FieldError.MessageKey] values; templates reference parameters
+// by name, e.g. "must be at least {min} characters".
+type MessageCatalog interface {
+	Message(key string) (string, bool)
+}
+
+// Catalog is a MessageCatalog backed by a map of keys to templates.
+type Catalog map[string]string
+
+// Message returns the template for key.
+func (c Catalog) Message(key string) (string, bool) {
+	msg, ok := c[key]
+	return msg, ok
+}
+
+// English returns a copy of the default English catalog.
+// It is a starting point for translations and for overriding individual messages.
+func English() Catalog {
+	c := make(Catalog, len(english))
+	for k, v := range english {
+		c[k] = v
+	}
+	return c
+}
+
+// ParseCatalog parses a JSON object of keys to templates.
+func ParseCatalog(data []byte) (Catalog, error) {
+	var c Catalog
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("check: parsing catalog: %w", err)
+	}
+	return c, nil
+}
+
+// LoadCatalog reads a JSON catalog from a file system, such as an embed.FS.
+func LoadCatalog(fsys fs.FS, name string) (Catalog, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, fmt.Errorf("check: loading catalog: %w", err)
+	}
+	return ParseCatalog(data)
+}
+
+// mustLoadCatalog is like LoadCatalog but panics on error.
+func mustLoadCatalog(fsys fs.FS, name string) Catalog {
+	c, err := LoadCatalog(fsys, name)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Translator selects message catalogs by language tag.
+type Translator struct {
+	catalogs map[string]MessageCatalog
+}
+
+// NewTranslator creates a Translator with the English catalog registered as "en".
+func NewTranslator() *Translator {
+	return &Translator{catalogs: map[string]MessageCatalog{"en": english}}
+}
+
+// Add registers a catalog for a language tag such as "de" or "pt-BR".
+func (t *Translator) Add(lang string, c MessageCatalog) *Translator {
+	t.catalogs[normalizeLang(lang)] = c
+	return t
+}
+
+// Catalog returns the catalog for a language tag.
+// Messages missing for the exact tag fall back to its base language
+// ("pt-BR" to "pt") and then to English.
+func (t *Translator) Catalog(lang string) MessageCatalog {
+	lang = normalizeLang(lang)
+	var chain fallbackCatalog
+	if c, ok := t.catalogs[lang]; ok {
+		chain = append(chain, c)
+	}
+	if base, _, found := strings.Cut(lang, "-"); found {
+		if c, ok := t.catalogs[base]; ok {
+			chain = append(chain, c)
+		}
+	}
+	if c, ok := t.catalogs["en"]; ok && lang != "en" {
+		chain = append(chain, c)
+	}
+	return chain
+}
+
+// normalizeLang lowercases a language tag and uses "-" as separator.
+func normalizeLang(lang string) string {
+	return strings.ToLower(strings.ReplaceAll(lang, "_", "-"))
+}
+
+// fallbackCatalog tries each catalog in order.
+type fallbackCatalog []MessageCatalog
+
+// Message returns the first template found for key.
+func (f fallbackCatalog) Message(key string) (string, bool) {
+	for _, c := range f {
+		if msg, ok := c.Message(key); ok {
+			return msg, true
+		}
+	}
+	return "", false
+}
+
+// Localize renders the error's message from the catalog.
+// Returns Message unchanged if the catalog has no template for the error,
+// or if the message was set with [WithMessage].
+func (e *FieldError) Localize(c MessageCatalog) string {
+	if c == nil || e.custom {
+		return e.Message
+	}
+	tmpl, ok := c.Message(e.MessageKey())
+	if !ok {
+		return e.Message
+	}
+	return renderMessage(tmpl, e.Params)
+}
+
+// Localize returns a copy of the Result with every FieldError message rendered
+// from the catalog. Applied validator tracking is preserved.
+func (r *Result) Localize(c MessageCatalog) *Result {
+	if r == nil {
+		return nil
+	}
+	return &Result{err: localizeErr(r.err, c), applied: r.applied, params: r.params, fields: r.fields}
+}
+
+// localizeErr returns a copy of err with FieldError messages rendered from the catalog.
+func localizeErr(err error, c MessageCatalog) error {
+	return rewriteFieldErrors(err, func(fe *FieldError) {
+		fe.Message = fe.Localize(c)
+	})
+}
+
+// renderMessage replaces "{name}" placeholders with parameter values.
+// Unknown placeholders are left as-is.
+func renderMessage(tmpl string, params map[string]any) string {
+	if len(params) == 0 || !strings.Contains(tmpl, "{") {
+		return tmpl
+	}
+	var b strings.Builder
+	for {
+		start := strings.Index(tmpl, "{")
+		if start == -1 {
+			break
+		}
+		end := strings.Index(tmpl[start:], "}")
+		if end == -1 {
+			break
+		}
+		end += start
+		b.WriteString(tmpl[:start])
+		if v, ok := params[tmpl[start+1:end]]; ok {
+			b.WriteString(formatParam(v))
+		} else {
+			b.WriteString(tmpl[start : end+1])
+		}
+		tmpl = tmpl[end+1:]
+	}
+	b.WriteString(tmpl)
+	return b.String()
+}
+
+// formatParam formats a parameter value for display in a message.
+func formatParam(v any) string {
+	if list, ok := v.([]string); ok {
+		return strings.Join(list, ", ")
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,250 @@
+package check
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+// failingValidations returns a failing validation for every built-in message.
+func failingValidations() []*Validation {
+	ref := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC) // Saturday
+	la, _ := time.LoadLocation("America/Los_Angeles")
+	return []*Validation{
+		Required("", "f"), NotBlank(" ", "f"), MinLen("a", 3, "f"), MaxLen("abcd", 3, "f"),
+		Len("a", 3, "f"), LenBetween("a", 2, 4, "f"), Match("a", regexp.MustCompile(`^b$`), "f"),
+		NotMatch("b", regexp.MustCompile(`^b$`), "f"), Prefix("a", "x", "f"), Suffix("a", "x", "f"),
+		Contains("a", "x", "f"), NotContains("x", "x", "f"), OneOf("c", []string{"a", "b"}, "f"),
+		NotOneOf("a", []string{"a", "b"}, "f"), Alpha("1", "f"), AlphaNumeric("!", "f"), Numeric("a", "f"),
+		ASCII("é", "f"), PrintableASCII("\x01", "f"), LowerCase("A", "f"), UpperCase("a", "f"),
+		NoWhitespace("a b", "f"), Trimmed(" a", "f"), SingleLine("a\nb", "f"), Identifier("", "f"),
+		Identifier("1a", "f"), Identifier("a-b", "f"), Slug("", "f"), Slug("-a", "f"), Slug("a--b", "f"),
+		Slug("A", "f"),
+		Email("x", "f"), URL("x", "f"), URLWithScheme("ftp://x.com", []string{"http", "https"}, "f"),
+		UUID("x", "f"), UUID4("x", "f"), IP("x", "f"), IPv4("::1", "f"), IPv6("1.2.3.4", "f"), CIDR("x", "f"),
+		MAC("x", "f"), Hostname("-", "f"), Port("0", "f"), HostPort("x", "f"), HostPort(":80", "f"),
+		HostPort("a:0", "f"), HexColor("x", "f"), HexColorFull("#fff", "f"), Base64("!", "f"),
+		Base64URL("!", "f"), JSON("{", "f"), Semver("x", "f"), E164("x", "f"), CreditCard("x", "f"),
+		Latitude("x", "f"), Longitude("x", "f"), CountryCode2("x", "f"), CountryCode3("x", "f"),
+		LanguageCode("x", "f"), CurrencyCode("x", "f"), Hex("x", "f"), DataURI("x", "f"), FilePath("", "f"),
+		UnixPath("", "f"),
+		Min(1, 2, "f"), Max(3, 2, "f"), Between(0, 1, 2, "f"), BetweenExclusive(1, 1, 2, "f"),
+		Positive(0, "f"), Negative(0, "f"), NonNegative(-1, "f"), NonPositive(1, "f"), Zero(1, "f"),
+		NonZero(0, "f"), MultipleOf(3, 0, "f"), MultipleOf(3, 2, "f"), Even(1, "f"), Odd(2, "f"),
+		OneOfValues(3, []int{1, 2}, "f"), NotOneOfValues(1, []int{1, 2}, "f"), GreaterThan(1, 2, "f"),
+		LessThan(2, 1, "f"), GreaterThanOrEqual(1, 2, "f"), LessThanOrEqual(2, 1, "f"),
+		Percentage(101, "f"), PortNumber(0, "f"), HTTPStatusCode(0, "f"),
+		Equal(1, 2, "f"), NotEqual(1, 1, "f"), EqualField(1, 2, "f", "g"), NotEqualField(1, 1, "f", "g"),
+		GreaterThanField(1, 2, "f", "g"), LessThanField(2, 1, "f", "g"),
+		GreaterThanOrEqualField(1, 2, "f", "g"), LessThanOrEqualField(2, 1, "f", "g"),
+		Before(ref, ref, "f"), After(ref, ref, "f"), BeforeOrEqual(ref.Add(time.Hour), ref, "f"),
+		AfterOrEqual(ref, ref.Add(time.Hour), "f"), BeforeNow(time.Now().Add(time.Hour), "f"),
+		AfterNow(ref, "f"), BeforeOrEqualNow(time.Now().Add(time.Hour), "f"), AfterOrEqualNow(ref, "f"),
+		BetweenTime(ref, ref.Add(time.Hour), ref.Add(2*time.Hour), "f"),
+		BetweenTimeExclusive(ref, ref, ref.Add(time.Hour), "f"), WithinDuration(ref, time.Second, "f"),
+		WithinDurationOf(ref, time.Second, ref.Add(time.Hour), "f"), SameDay(ref, ref.AddDate(0, 0, 1), "f"),
+		SameMonth(ref, ref.AddDate(0, 1, 0), "f"), SameYear(ref, ref.AddDate(1, 0, 0), "f"),
+		Weekday(ref, time.Monday, "f"), WeekdayIn(ref, []time.Weekday{time.Monday}, "f"),
+		NotWeekend(ref, "f"), IsWeekend(ref.AddDate(0, 0, 2), "f"), NotZeroTime(time.Time{}, "f"),
+		ZeroTime(ref, "f"), TimeInTimezone(ref, nil, "f"), TimeInTimezone(ref, la, "f"),
+		DurationMin(time.Second, time.Minute, "f"), DurationMax(time.Hour, time.Minute, "f"),
+		DurationBetween(time.Second, time.Minute, time.Hour, "f"), DurationPositive(0, "f"),
+		DurationNonNegative(-1, "f"),
+		NotEmpty([]int{}, "f"), Empty([]int{1}, "f"), MinItems([]int{}, 1, "f"), MaxItems([]int{1, 2}, 1, "f"),
+		ExactItems([]int{}, 1, "f"), ItemsBetween([]int{}, 1, 2, "f"), Unique([]int{1, 1}, "f"),
+		SliceContains([]int{}, 1, "f"), SliceNotContains([]int{1}, 1, "f"), ContainsAll([]int{}, []int{1}, "f"),
+		ContainsAny([]int{}, []int{1}, "f"), ContainsNone([]int{1}, []int{1}, "f"),
+		Subset([]int{3}, []int{1}, "f"), Disjoint([]int{1}, []int{1}, "f"),
+		NotEmptyMap(map[string]int{}, "f"), EmptyMap(map[string]int{"a": 1}, "f"),
+		MinKeys(map[string]int{}, 1, "f"), MaxKeys(map[string]int{"a": 1}, 0, "f"),
+		ExactKeys(map[string]int{}, 1, "f"), KeysBetween(map[string]int{}, 1, 2, "f"),
+		HasKey(map[string]int{}, "a", "f"), HasKeys(map[string]int{}, []string{"a"}, "f"),
+		HasAnyKey(map[string]int{}, []string{"a"}, "f"), NotHasKey(map[string]int{"a": 1}, "a", "f"),
+		NotHasKeys(map[string]int{"a": 1}, []string{"a"}, "f"), OnlyKeys(map[string]int{"a": 1}, []string{}, "f"),
+		UniqueValues(map[string]int{"a": 1, "b": 1}, "f"),
+		NotNil[int](nil, "f"), Nil(Ptr(1), "f"), NotNilInterface(nil, "f"),
+		RequiredPtr[int](nil, func(int) *Validation { return nil }, "f"),
+		Int(0, "f").Positive().Negative().V(), Int(-1, "f").NonNegative().V(), Int(1, "f").NonPositive().V(),
+		ExactlyOne("f", UUID("-", "f"), Slug("-", "f")),
+		RequiredIf("", "business", "business", "f", "kind"), RequiredUnless("", "personal", "business", "f", "kind"),
+		RequiredWith("", "f", Ref(1, "g")), RequiredWithAll("", "f", Ref(1, "g"), Ref("x", "h")),
+		RequiredWithout[*int](nil, "f", Ref("", "g")), ExcludedIf(1, "business", "business", "f", "kind"),
+		ExcludedWith("x", "f", Ref(Ptr(1), "g")),
+		AtLeastOneOf(Ref("", "f"), Ref("", "g")), ExactlyOneOf(Ref("x", "f"), Ref("y", "g")),
+		AtMostOneOf(Ref("x", "f"), Ref("y", "g")), AllOrNone(Ref("x", "f"), Ref("", "g")),
+	}
+}
+
+func TestEnglishCatalogMatchesMessages(t *testing.T) {
+	catalog := English()
+	for _, v := range failingValidations() {
+		if !v.Failed() {
+			t.Errorf("expected failure for %v", v.validators)
+			continue
+		}
+		for _, fe := range GetFieldErrors(All(v)) {
+			if _, ok := catalog.Message(fe.MessageKey()); !ok {
+				t.Errorf("no English message for key %q (%s)", fe.MessageKey(), fe.Message)
+				continue
+			}
+			if got := fe.Localize(catalog); got != fe.Message {
+				t.Errorf("key %q: catalog renders %q, validator says %q", fe.MessageKey(), got, fe.Message)
+			}
+		}
+	}
+}
+
+func TestFieldErrorMessageKey(t *testing.T) {
+	fe := MinLen("a", 3, "f").Err().(*FieldError) //nolint:errorlint // testing concrete type
+	if fe.Code != "min" || fe.MessageKey() != "min.string" {
+		t.Errorf("unexpected code/key: %s/%s", fe.Code, fe.MessageKey())
+	}
+	fe = Min(1, 3, "f").Err().(*FieldError) //nolint:errorlint // testing concrete type
+	if fe.MessageKey() != "min" {
+		t.Errorf("expected key to default to code, got %s", fe.MessageKey())
+	}
+}
+
+func TestFieldErrorLocalize(t *testing.T) {
+	german := Catalog{
+		"required":   "ist erforderlich",
+		"min.string": "muss mindestens {min} Zeichen lang sein",
+	}
+
+	t.Run("renders params", func(t *testing.T) {
+		fe := MinLen("a", 8, "password").Err().(*FieldError) //nolint:errorlint // testing concrete type
+		if got := fe.Localize(german); got != "muss mindestens 8 Zeichen lang sein" {
+			t.Errorf("unexpected: %s", got)
+		}
+	})
+
+	t.Run("falls back to Message", func(t *testing.T) {
+		fe := Email("x", "email").Err().(*FieldError) //nolint:errorlint // testing concrete type
+		if got := fe.Localize(german); got != "must be a valid email address" {
+			t.Errorf("unexpected: %s", got)
+		}
+	})
+
+	t.Run("nil catalog", func(t *testing.T) {
+		fe := Email("x", "email").Err().(*FieldError) //nolint:errorlint // testing concrete type
+		if got := fe.Localize(nil); got != fe.Message {
+			t.Errorf("unexpected: %s", got)
+		}
+	})
+}
+
+func TestResultLocalize(t *testing.T) {
+	german := Catalog{"required": "ist erforderlich", "email": "muss eine gültige E-Mail-Adresse sein"}
+	r := All(
+		Str("", "email").Required().Email().V(),
+		Str("x", "name").Required().V(),
+	)
+	localized := r.Localize(german)
+
+	if localized.Error() != "email: ist erforderlich; email: muss eine gültige E-Mail-Adresse sein" {
+		t.Errorf("unexpected: %s", localized.Error())
+	}
+	if r.Error() != "email: is required; email: must be a valid email address" {
+		t.Errorf("original was modified: %s", r.Error())
+	}
+	if !localized.HasValidator("name", "required") {
+		t.Error("expected tracking to be preserved")
+	}
+
+	var fe *FieldError
+	if !errors.As(localized.Err(), &fe) || fe.Code != "required" {
+		t.Error("expected codes to be preserved")
+	}
+
+	if (*Result)(nil).Localize(german) != nil {
+		t.Error("expected nil for nil result")
+	}
+}
+
+func TestParseCatalog(t *testing.T) {
+	c, err := ParseCatalog([]byte(`{"required": "est requis"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msg, ok := c.Message("required"); !ok || msg != "est requis" {
+		t.Errorf("unexpected: %q %v", msg, ok)
+	}
+
+	if _, err := ParseCatalog([]byte(`not json`)); err == nil {
+		t.Error("expected error for invalid JSON")
+	}
+}
+
+func TestLoadCatalog(t *testing.T) {
+	fsys := fstest.MapFS{
+		"locales/fr.json": {Data: []byte(`{"required": "est requis"}`)},
+	}
+	c, err := LoadCatalog(fsys, "locales/fr.json")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msg, _ := c.Message("required"); msg != "est requis" {
+		t.Errorf("unexpected: %q", msg)
+	}
+
+	if _, err := LoadCatalog(fsys, "locales/missing.json"); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
+
+func TestEnglish(t *testing.T) {
+	c := English()
+	c["required"] = "changed"
+	if msg, _ := English().Message("required"); msg != "is required" {
+		t.Errorf("expected English to return a copy, got %q", msg)
+	}
+}
+
+func TestTranslator(t *testing.T) {
+	tr := NewTranslator().
+		Add("pt", Catalog{"required": "é obrigatório", "email": "deve ser um e-mail válido"}).
+		Add("pt_BR", Catalog{"required": "é obrigatório (BR)"})
+
+	fe := Required("", "f").Err().(*FieldError)   //nolint:errorlint // testing concrete type
+	em := Email("x", "f").Err().(*FieldError)     //nolint:errorlint // testing concrete type
+	ml := MinLen("a", 2, "f").Err().(*FieldError) //nolint:errorlint // testing concrete type
+
+	tests := []struct {
+		lang string
+		fe   *FieldError
+		want string
+	}{
+		{"pt-BR", fe, "é obrigatório (BR)"},
+		{"PT-br", em, "deve ser um e-mail válido"},
+		{"pt-BR", ml, "must be at least 2 characters"},
+		{"pt", fe, "é obrigatório"},
+		{"de", fe, "is required"},
+		{"en", ml, "must be at least 2 characters"},
+	}
+	for _, tt := range tests {
+		if got := tt.fe.Localize(tr.Catalog(tt.lang)); got != tt.want {
+			t.Errorf("%s %s: got %q, want %q", tt.lang, tt.fe.MessageKey(), got, tt.want)
+		}
+	}
+}
+
+func TestRenderMessage(t *testing.T) {
+	tests := []struct {
+		tmpl   string
+		params map[string]any
+		want   string
+	}{
+		{"plain", nil, "plain"},
+		{"at least {min}", map[string]any{"min": 3}, "at least 3"},
+		{"one of: {allowed}", map[string]any{"allowed": []string{"a", "b"}}, "one of: a, b"},
+		{"{unknown} stays", map[string]any{"min": 3}, "{unknown} stays"},
+		{"unclosed {min", map[string]any{"min": 3}, "unclosed {min"},
+	}
+	for _, tt := range tests {
+		if got := renderMessage(tt.tmpl, tt.params); got != tt.want {
+			t.Errorf("renderMessage(%q) = %q, want %q", tt.tmpl, got, tt.want)
+		}
+	}
+}
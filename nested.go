@@ -0,0 +1,69 @@
+package check
+
+// Validator is implemented by types that validate themselves.
+type Validator interface {
+	Validate() *Result
+}
+
+// Nested runs a child value's validation and prefixes its field paths with field.
+// Errors for "zip" become "shipping.zip", and so do the applied validators, so the
+// result can be passed to All or Check[T] alongside the parent's own validations.
+//
+// Nested composes with SliceBuilder.EachV, producing paths like "items[3].price":
+//
+//	check.Slice(o.Items, "items").EachV(check.Nested[LineItem]).V()
+func Nested[T Validator](v T, field string) *Validation {
+	return nestedResult(v.Validate(), field)
+}
+
+// OptNested is like Nested but skips validation when the pointer is nil.
+// Works with both value and pointer receivers of Validate.
+func OptNested[T any, P interface {
+	*T
+	Validator
+}](v *T, field string) *Validation {
+	if v == nil {
+		return nil
+	}
+	return nestedResult(P(v).Validate(), field)
+}
+
+// nestedResult converts a child Result into a Validation rooted at prefix.
+func nestedResult(r *Result, prefix string) *Validation {
+	if r == nil {
+		return nil
+	}
+
+	nested := make([]*Validation, 0, len(r.applied))
+	for _, field := range r.Fields() {
+		nested = append(nested, &Validation{field: joinPath(prefix, field), validators: r.applied[field], params: r.params[field]})
+	}
+
+	return &Validation{err: prefixErr(r.err, prefix), field: prefix, nested: nested}
+}
+
+// prefixErr returns a copy of err with every field path prefixed.
+// Errors that carry no field are returned unchanged.
+func prefixErr(err error, prefix string) error {
+	switch e := err.(type) { //nolint:errorlint // rewriting concrete errors, not matching them
+	case Errors:
+		prefixed := make(Errors, len(e))
+		for i, inner := range e {
+			prefixed[i] = prefixErr(inner, prefix)
+		}
+		return prefixed
+	case *FieldError:
+		fe := *e
+		fe.Field = joinPath(prefix, e.Field)
+		return &fe
+	case *UncheckedFieldError:
+		ue := *e
+		ue.Field = joinPath(prefix, e.Field)
+		return &ue
+	case *MissingRuleError:
+		me := *e
+		me.Field = joinPath(prefix, e.Field)
+		return &me
+	}
+	return err
+}
@@ -0,0 +1,162 @@
+package check
+
+import (
+	"errors"
+	"testing"
+)
+
+type nestedAddress struct {
+	Street string `json:"street" validate:"required"`
+	Zip    string `json:"zip" validate:"required,len=5"`
+}
+
+func (a nestedAddress) Validate() *Result {
+	return Check[nestedAddress](
+		Str(a.Street, "street").Required().V(),
+		Str(a.Zip, "zip").Required().Len(5).V(),
+	)
+}
+
+type nestedItem struct {
+	SKU   string  `json:"sku" validate:"required"`
+	Price float64 `json:"price" validate:"gt=0"`
+}
+
+func (i *nestedItem) Validate() *Result {
+	return All(
+		Str(i.SKU, "sku").Required().V(),
+		Num(i.Price, "price").GreaterThan(0).V(),
+	)
+}
+
+type nestedOrder struct {
+	Shipping nestedAddress  `json:"shipping"`
+	Billing  *nestedAddress `json:"billing"`
+	Items    []*nestedItem  `json:"items" validate:"required"`
+}
+
+func TestNested(t *testing.T) {
+	t.Run("prefixes field errors", func(t *testing.T) {
+		v := Nested(nestedAddress{Street: "Main St", Zip: "123"}, "shipping")
+		if !v.Failed() {
+			t.Fatal("expected failure")
+		}
+		r := All(v)
+		if !HasField(r, "shipping.zip") {
+			t.Errorf("expected error for shipping.zip, got: %v", r.Err())
+		}
+		if r.Error() != "shipping.zip: must be exactly 5 characters" {
+			t.Errorf("unexpected error: %s", r.Error())
+		}
+	})
+
+	t.Run("prefixes applied validators", func(t *testing.T) {
+		r := All(Nested(nestedAddress{Street: "Main St", Zip: "12345"}, "shipping"))
+		if r.Err() != nil {
+			t.Fatalf("expected pass, got: %v", r.Err())
+		}
+		if !r.HasValidator("shipping.street", "required") {
+			t.Error("expected shipping.street to have required")
+		}
+		if !r.HasValidator("shipping.zip", "len") {
+			t.Error("expected shipping.zip to have len")
+		}
+		if _, ok := r.Applied()["zip"]; ok {
+			t.Error("expected unprefixed zip to be absent")
+		}
+	})
+
+	t.Run("does not modify child errors", func(t *testing.T) {
+		child := nestedAddress{Zip: "1"}.Validate()
+		All(Nested(nestedAddress{Zip: "1"}, "shipping"))
+		for _, fe := range GetFieldErrors(child) {
+			if fe.Field != "street" && fe.Field != "zip" {
+				t.Errorf("child error was modified: %s", fe.Field)
+			}
+		}
+	})
+
+	t.Run("prefixes unchecked field errors", func(t *testing.T) {
+		v := Nested(partialAddress{}, "shipping")
+		var ue *UncheckedFieldError
+		if !errors.As(v.Err(), &ue) {
+			t.Fatalf("expected UncheckedFieldError, got: %v", v.Err())
+		}
+		if ue.Field != "shipping.zip" {
+			t.Errorf("expected shipping.zip, got %s", ue.Field)
+		}
+	})
+
+	t.Run("composes with EachV", func(t *testing.T) {
+		items := []*nestedItem{{SKU: "A", Price: 1}, {SKU: "", Price: 0}}
+		r := All(Slice(items, "items").NotEmpty().EachV(Nested[*nestedItem]).V())
+		if !HasField(r, "items[1].sku") || !HasField(r, "items[1].price") {
+			t.Errorf("expected indexed errors, got: %v", r.Err())
+		}
+		if !r.HasValidator("items[0].price", "gt") {
+			t.Error("expected items[0].price to have gt")
+		}
+		if !r.HasValidator("items", "required") {
+			t.Error("expected items to have required")
+		}
+	})
+
+	t.Run("works with Check", func(t *testing.T) {
+		o := nestedOrder{
+			Shipping: nestedAddress{Street: "Main St", Zip: "12345"},
+			Items:    []*nestedItem{{SKU: "A", Price: 1}},
+		}
+		r := Check[nestedOrder](
+			Nested(o.Shipping, "shipping"),
+			OptNested(o.Billing, "billing"),
+			Slice(o.Items, "items").NotEmpty().EachV(Nested[*nestedItem]).V(),
+		)
+		if r.Err() != nil {
+			t.Errorf("expected pass, got: %v", r.Err())
+		}
+	})
+
+	t.Run("nil result", func(t *testing.T) {
+		if v := Nested(nilValidator{}, "x"); v != nil {
+			t.Errorf("expected nil, got %v", v)
+		}
+	})
+}
+
+func TestOptNested(t *testing.T) {
+	t.Run("nil skips", func(t *testing.T) {
+		var a *nestedAddress
+		if v := OptNested(a, "billing"); v != nil {
+			t.Errorf("expected nil, got %v", v)
+		}
+	})
+
+	t.Run("value receiver", func(t *testing.T) {
+		a := &nestedAddress{Street: "Main St"}
+		r := All(OptNested(a, "billing"))
+		if !HasField(r, "billing.zip") {
+			t.Errorf("expected billing.zip error, got: %v", r.Err())
+		}
+	})
+
+	t.Run("pointer receiver", func(t *testing.T) {
+		i := &nestedItem{SKU: "A"}
+		r := All(OptNested(i, "item"))
+		if !HasField(r, "item.price") {
+			t.Errorf("expected item.price error, got: %v", r.Err())
+		}
+	})
+}
+
+type partialAddress struct {
+	Street string `json:"street" validate:"required"`
+	Zip    string `json:"zip" validate:"required"`
+}
+
+func (a partialAddress) Validate() *Result {
+	return Check[partialAddress](Str(a.Street, "street").Required().V())
+}
+
+type nilValidator struct{}
+
+func (nilValidator) Validate() *Result { return nil }
@@ -10,7 +10,7 @@ func Min[T constraints.Ordered](v, minVal T, field string) *Validation {
 	if v < minVal {
 		err = fieldErrf(field, "must be at least %v", minVal)
 	}
-	return validation(err, field, "min")
+	return validation(err, field, "min").with("min", minVal)
 }
 
 // Max validates that a value is at most the maximum.
@@ -19,25 +19,25 @@ func Max[T constraints.Ordered](v, maxVal T, field string) *Validation {
 	if v > maxVal {
 		err = fieldErrf(field, "must be at most %v", maxVal)
 	}
-	return validation(err, field, "max")
+	return validation(err, field, "max").with("max", maxVal)
 }
 
 // Between validates that a value is within a range (inclusive).
 func Between[T constraints.Ordered](v, minVal, maxVal T, field string) *Validation {
 	var err error
 	if v < minVal || v > maxVal {
-		err = fieldErrf(field, "must be between %v and %v", minVal, maxVal)
+		err = fieldErrf(field, "must be between %v and %v", minVal, maxVal).withKey("between").withCode(rangeCode(v > maxVal, "min", "max"))
 	}
-	return validation(err, field, "min", "max")
+	return validation(err, field, "min", "max").with("min", minVal).with("max", maxVal)
 }
 
 // BetweenExclusive validates that a value is within a range (exclusive).
 func BetweenExclusive[T constraints.Ordered](v, minVal, maxVal T, field string) *Validation {
 	var err error
 	if v <= minVal || v >= maxVal {
-		err = fieldErrf(field, "must be between %v and %v (exclusive)", minVal, maxVal)
+		err = fieldErrf(field, "must be between %v and %v (exclusive)", minVal, maxVal).withKey("between.exclusive").withCode(rangeCode(v >= maxVal, "gt", "lt"))
 	}
-	return validation(err, field, "gt", "lt")
+	return validation(err, field, "gt", "lt").with("min", minVal).with("max", maxVal)
 }
 
 // Signed is a constraint for signed numeric types.
@@ -69,65 +69,65 @@ type Number interface {
 func Positive[T Signed | Float](v T, field string) *Validation {
 	var err error
 	if v <= 0 {
-		err = fieldErr(field, "must be positive")
+		err = fieldErr(field, "must be positive").withKey("gt.positive")
 	}
-	return validation(err, field, "gt")
+	return validation(err, field, "gt").with("threshold", 0)
 }
 
 // Negative validates that a value is less than zero.
 func Negative[T Signed | Float](v T, field string) *Validation {
 	var err error
 	if v >= 0 {
-		err = fieldErr(field, "must be negative")
+		err = fieldErr(field, "must be negative").withKey("lt.negative")
 	}
-	return validation(err, field, "lt")
+	return validation(err, field, "lt").with("threshold", 0)
 }
 
 // NonNegative validates that a value is zero or greater.
 func NonNegative[T Signed | Float](v T, field string) *Validation {
 	var err error
 	if v < 0 {
-		err = fieldErr(field, "must not be negative")
+		err = fieldErr(field, "must not be negative").withKey("gte.nonnegative")
 	}
-	return validation(err, field, "gte")
+	return validation(err, field, "gte").with("threshold", 0)
 }
 
 // NonPositive validates that a value is zero or less.
 func NonPositive[T Signed | Float](v T, field string) *Validation {
 	var err error
 	if v > 0 {
-		err = fieldErr(field, "must not be positive")
+		err = fieldErr(field, "must not be positive").withKey("lte.nonpositive")
 	}
-	return validation(err, field, "lte")
+	return validation(err, field, "lte").with("threshold", 0)
 }
 
 // Zero validates that a value is exactly zero.
 func Zero[T Number](v T, field string) *Validation {
 	var err error
 	if v != 0 {
-		err = fieldErr(field, "must be zero")
+		err = fieldErr(field, "must be zero").withKey("eq.zero")
 	}
-	return validation(err, field, "eq")
+	return validation(err, field, "eq").with("value", 0)
 }
 
 // NonZero validates that a value is not zero.
 func NonZero[T Number](v T, field string) *Validation {
 	var err error
 	if v == 0 {
-		err = fieldErr(field, "must not be zero")
+		err = fieldErr(field, "must not be zero").withKey("ne.zero")
 	}
-	return validation(err, field, "ne")
+	return validation(err, field, "ne").with("value", 0)
 }
 
 // MultipleOf validates that a value is a multiple of the given divisor.
 func MultipleOf[T Integer](v, divisor T, field string) *Validation {
 	var err error
 	if divisor == 0 {
-		err = fieldErr(field, "divisor must not be zero")
+		err = fieldErr(field, "divisor must not be zero").withKey("multipleof.zero_divisor")
 	} else if v%divisor != 0 {
 		err = fieldErrf(field, "must be a multiple of %v", divisor)
 	}
-	return validation(err, field, "multipleof")
+	return validation(err, field, "multipleof").with("divisor", divisor)
 }
 
 // Even validates that an integer value is even.
@@ -159,9 +159,9 @@ func OneOfValues[T comparable](v T, allowed []T, field string) *Validation {
 		}
 	}
 	if !found {
-		err = fieldErrf(field, "must be one of the allowed values")
+		err = fieldErrf(field, "must be one of the allowed values").withKey("oneof.values")
 	}
-	return validation(err, field, "oneof")
+	return validation(err, field, "oneof").with("allowed", allowed)
 }
 
 // NotOneOfValues validates that a value is not one of the disallowed values.
@@ -169,11 +169,11 @@ func NotOneOfValues[T comparable](v T, disallowed []T, field string) *Validation
 	var err error
 	for _, d := range disallowed {
 		if v == d {
-			err = fieldErr(field, "must not be one of the disallowed values")
+			err = fieldErr(field, "must not be one of the disallowed values").withKey("notoneof.values")
 			break
 		}
 	}
-	return validation(err, field, "notoneof")
+	return validation(err, field, "notoneof").with("disallowed", disallowed)
 }
 
 // GreaterThan validates that a value is strictly greater than the threshold.
@@ -182,7 +182,7 @@ func GreaterThan[T constraints.Ordered](v, threshold T, field string) *Validatio
 	if v <= threshold {
 		err = fieldErrf(field, "must be greater than %v", threshold)
 	}
-	return validation(err, field, "gt")
+	return validation(err, field, "gt").with("threshold", threshold)
 }
 
 // LessThan validates that a value is strictly less than the threshold.
@@ -191,7 +191,7 @@ func LessThan[T constraints.Ordered](v, threshold T, field string) *Validation {
 	if v >= threshold {
 		err = fieldErrf(field, "must be less than %v", threshold)
 	}
-	return validation(err, field, "lt")
+	return validation(err, field, "lt").with("threshold", threshold)
 }
 
 // GreaterThanOrEqual validates that a value is greater than or equal to the threshold.
@@ -200,7 +200,7 @@ func GreaterThanOrEqual[T constraints.Ordered](v, threshold T, field string) *Va
 	if v < threshold {
 		err = fieldErrf(field, "must be greater than or equal to %v", threshold)
 	}
-	return validation(err, field, "gte")
+	return validation(err, field, "gte").with("threshold", threshold)
 }
 
 // LessThanOrEqual validates that a value is less than or equal to the threshold.
@@ -209,16 +209,16 @@ func LessThanOrEqual[T constraints.Ordered](v, threshold T, field string) *Valid
 	if v > threshold {
 		err = fieldErrf(field, "must be less than or equal to %v", threshold)
 	}
-	return validation(err, field, "lte")
+	return validation(err, field, "lte").with("threshold", threshold)
 }
 
 // Percentage validates that a value is between 0 and 100.
 func Percentage[T Number](v T, field string) *Validation {
 	var err error
 	if v < 0 || v > 100 {
-		err = fieldErr(field, "must be a percentage (0-100)")
+		err = fieldErr(field, "must be a percentage (0-100)").withKey("percentage").withCode(rangeCode(v > 100, "min", "max"))
 	}
-	return validation(err, field, "min", "max")
+	return validation(err, field, "min", "max").with("min", 0).with("max", 100)
 }
 
 // PortNumber validates that a value is a valid port number (1-65535).
@@ -227,7 +227,7 @@ func PortNumber(v int, field string) *Validation {
 	if v < 1 || v > 65535 {
 		err = fieldErr(field, "must be a valid port number (1-65535)")
 	}
-	return validation(err, field, "port")
+	return validation(err, field, "port").with("min", 1).with("max", 65535)
 }
 
 // HTTPStatusCode validates that a value is a valid HTTP status code (100-599).
@@ -236,5 +236,5 @@ func HTTPStatusCode(v int, field string) *Validation {
 	if v < 100 || v > 599 {
 		err = fieldErr(field, "must be a valid HTTP status code (100-599)")
 	}
-	return validation(err, field, "httpstatus")
+	return validation(err, field, "httpstatus").with("min", 100).with("max", 599)
 }
@@ -0,0 +1,290 @@
+package check
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// OpenAPIComponents collects the schemas of validated types for the
+// components.schemas section of an OpenAPI 3.1 document, whose schemas are
+// JSON Schema draft 2020-12.
+//
+// Each schema is derived as by [SchemaFor], and in addition:
+//   - fields validated with Required, RequiredPtr or a builder for values,
+//     such as Str or Num rather than OptStr, are listed as required
+//   - pointer fields that are not required are nullable
+//   - descriptions are rendered from the default English messages of the
+//     validators applied, e.g. "must be at least 8 characters"
+//   - fields whose types are components themselves refer to them with $ref
+//
+// Usage:
+//
+//	c := check.NewOpenAPIComponents()
+//	check.AddComponent[Signup](c, Signup{}.Validate())
+//	check.AddComponent[Address](c, Address{}.Validate())
+//	doc, err := c.YAML()
+type OpenAPIComponents struct {
+	components []component
+}
+
+// component is a type added to OpenAPIComponents.
+type component struct {
+	name     string
+	typ      reflect.Type
+	recorded map[string][]AppliedValidator
+}
+
+// NewOpenAPIComponents returns an empty set of components.
+func NewOpenAPIComponents() *OpenAPIComponents {
+	return &OpenAPIComponents{}
+}
+
+// AddComponent adds T to c, named by its Go type name, with the validators
+// recorded in results, such as the Result of a dry run of T's Validate method.
+func AddComponent[T any](c *OpenAPIComponents, results ...*Result) *OpenAPIComponents {
+	t := reflect.TypeFor[T]()
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	c.components = append(c.components, component{
+		name:     componentName(t),
+		typ:      t,
+		recorded: recordedValidators(results),
+	})
+	return c
+}
+
+// componentNameUnsafe matches the characters OpenAPI does not allow in
+// component names, as in the type arguments of generic types.
+var componentNameUnsafe = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// componentName names the component of a type, e.g. "Page_Signup" for Page[Signup].
+func componentName(t reflect.Type) string {
+	name := t.Name()
+	if i := strings.Index(name, "["); i != -1 {
+		args := name[i+1 : len(name)-1]
+		args = args[strings.LastIndex(args, ".")+1:]
+		name = name[:i] + "_" + args
+	}
+	return strings.Trim(componentNameUnsafe.ReplaceAllString(name, "_"), "_")
+}
+
+// componentRef returns the $ref of a component.
+func componentRef(name string) string {
+	return "#/components/schemas/" + name
+}
+
+// Schemas returns the schemas of the components by name. It fails if two
+// types have the same name or a type has none.
+func (c *OpenAPIComponents) Schemas() (map[string]*JSONSchema, error) {
+	refs := make(map[reflect.Type]string, len(c.components))
+	for _, comp := range c.components {
+		if comp.name == "" {
+			return nil, fmt.Errorf("check: component type %s has no name", comp.typ)
+		}
+		for t, name := range refs {
+			if name == comp.name && t != comp.typ {
+				return nil, fmt.Errorf("check: component name %s is used by %s and %s", name, t, comp.typ)
+			}
+		}
+		refs[comp.typ] = comp.name
+	}
+
+	schemas := make(map[string]*JSONSchema, len(c.components))
+	for _, comp := range c.components {
+		b := schemaBuilder{recorded: comp.recorded, refs: refs, openAPI: true}
+		schemas[comp.name], _ = b.schema(comp.typ, "", "", nil)
+	}
+	return schemas, nil
+}
+
+// JSON returns the components as an indented JSON document of the form
+// {"components": {"schemas": {...}}}, for merging into an OpenAPI document.
+func (c *OpenAPIComponents) JSON() ([]byte, error) {
+	schemas, err := c.Schemas()
+	if err != nil {
+		return nil, err
+	}
+	doc := map[string]any{"components": map[string]any{"schemas": schemas}}
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// YAML returns the components as a YAML document, with the same content and
+// key order as JSON.
+func (c *OpenAPIComponents) YAML() ([]byte, error) {
+	data, err := c.JSON()
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var b bytes.Buffer
+	if err := writeYAML(&b, dec, 0, ""); err != nil {
+		return nil, fmt.Errorf("check: encoding YAML: %w", err)
+	}
+	return b.Bytes(), nil
+}
+
+// writeYAML writes the next JSON value from dec as YAML, indented by indent
+// spaces. prefix is written before the value: "" at the top level, or a key
+// or list marker the value follows.
+func writeYAML(w *bytes.Buffer, dec *json.Decoder, indent int, prefix string) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	pad := strings.Repeat(" ", indent)
+	switch tok {
+	case json.Delim('{'):
+		if !dec.More() {
+			w.WriteString(prefix + " {}\n")
+			_, err = dec.Token()
+			return err
+		}
+		first := true
+		for dec.More() {
+			keyTok, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			key, ok := keyTok.(string)
+			if !ok {
+				return fmt.Errorf("unexpected object key %v", keyTok)
+			}
+			line := pad + yamlString(key) + ":"
+			switch {
+			case first && strings.HasSuffix(prefix, "-"):
+				// The first entry of an object in a list follows the marker.
+				line = prefix + " " + yamlString(key) + ":"
+			case first && prefix != "":
+				w.WriteString(prefix + "\n")
+			}
+			first = false
+			if err := writeYAML(w, dec, indent+2, line); err != nil {
+				return err
+			}
+		}
+		_, err = dec.Token()
+		return err
+	case json.Delim('['):
+		if !dec.More() {
+			w.WriteString(prefix + " []\n")
+			_, err = dec.Token()
+			return err
+		}
+		if prefix != "" {
+			w.WriteString(prefix + "\n")
+		}
+		for dec.More() {
+			// Items are indented under their key, with object entries two
+			// spaces further to line up after the "- " marker.
+			if err := writeYAML(w, dec, indent+2, pad+"-"); err != nil {
+				return err
+			}
+		}
+		_, err = dec.Token()
+		return err
+	}
+
+	var scalar string
+	switch v := tok.(type) {
+	case string:
+		scalar = yamlString(v)
+	case json.Number:
+		scalar = v.String()
+	case bool:
+		scalar = fmt.Sprint(v)
+	case nil:
+		scalar = "null"
+	default:
+		return fmt.Errorf("unexpected token %v", tok)
+	}
+	w.WriteString(prefix + " " + scalar + "\n")
+	return nil
+}
+
+// yamlPlain matches strings that YAML reads back unchanged without quotes.
+var yamlPlain = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_./ -]*$`)
+
+// yamlKeywords are plain scalars YAML reads as something other than a string.
+var yamlKeywords = []string{"true", "false", "yes", "no", "on", "off", "null", "y", "n"}
+
+// yamlString returns s as a YAML scalar, quoted in JSON style when a plain
+// scalar would be read differently.
+func yamlString(s string) string {
+	if yamlPlain.MatchString(s) && !strings.HasSuffix(s, " ") && !slices.Contains(yamlKeywords, strings.ToLower(s)) {
+		return s
+	}
+	quoted, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Sprintf("%q", s)
+	}
+	return string(quoted)
+}
+
+// describe renders the default English messages of the validators applied to
+// a value of type t, skipping required, which the required list describes.
+func describe(t reflect.Type, applied []AppliedValidator) string {
+	var messages []string
+	for _, a := range applied {
+		if a.Name == "required" {
+			continue
+		}
+		tmpl, ok := english.Message(messageKey(t, a))
+		if !ok {
+			continue
+		}
+		if len(a.Params) == 0 && strings.Contains(tmpl, "{") {
+			// Recorded without being run, e.g. by a skipped Opt builder step,
+			// so there are no parameters to render.
+			continue
+		}
+		if msg := renderMessage(tmpl, a.Params); !slices.Contains(messages, msg) {
+			messages = append(messages, msg)
+		}
+	}
+	return strings.Join(messages, "; ")
+}
+
+// messageKey returns the catalog key of a validator's message for a value
+// of type t, qualified by kind where the message depends on it.
+func messageKey(t reflect.Type, a AppliedValidator) string {
+	name := a.Name
+	if negated, _ := a.Params["negated"].(bool); negated {
+		return name + ".not"
+	}
+	var qualified string
+	switch {
+	case t == timeType:
+		qualified = name + ".time"
+	case t.Kind() == reflect.String && (name == "min" || name == "max"):
+		qualified = name + ".string"
+	case (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && name == "len":
+		qualified = name + ".items"
+	case t.Kind() == reflect.Map && name == "len":
+		qualified = name + ".keys"
+	case t.Kind() == reflect.Map && name == "unique":
+		qualified = name + ".values"
+	}
+	if _, ok := english.Message(qualified); ok {
+		return qualified
+	}
+	return name
+}
+
+// recordedValidators indexes the validators recorded in results by element path.
+func recordedValidators(results []*Result) map[string][]AppliedValidator {
+	recorded := make(map[string][]AppliedValidator)
+	for _, r := range results {
+		for _, field := range r.Fields() {
+			path := elementPath(field)
+			recorded[path] = append(recorded[path], r.AppliedValidators(field)...)
+		}
+	}
+	return recorded
+}
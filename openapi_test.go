@@ -0,0 +1,194 @@
+package check
+
+import (
+	"strings"
+	"testing"
+)
+
+type apiAddress struct {
+	Street string `json:"street" validate:"required"`
+	Zip    string `json:"zip" validate:"required,len=5"`
+}
+
+type apiSignup struct {
+	Email    string      `json:"email"`
+	Password string      `json:"password" validate:"min=8"`
+	Nickname *string     `json:"nickname"`
+	Age      int         `json:"age"`
+	Note     *string     `json:"note"`
+	Home     apiAddress  `json:"home"`
+	Work     *apiAddress `json:"work"`
+}
+
+func (s apiSignup) Validate() *Result {
+	return All(
+		Str(s.Email, "email").Required().Email().MaxLen(255).V(),
+		OptStr(s.Nickname, "nickname").MaxLen(20).V(),
+		Num(s.Age, "age").Between(18, 130).V(),
+		RequiredPtr(s.Note, func(v string) *Validation { return MaxLen(v, 500, "note") }, "note"),
+	)
+}
+
+type apiPage[T any] struct {
+	Items []T `json:"items" validate:"max=50"`
+}
+
+func apiComponents() *OpenAPIComponents {
+	c := NewOpenAPIComponents()
+	AddComponent[apiSignup](c, apiSignup{}.Validate())
+	AddComponent[*apiAddress](c)
+	return c
+}
+
+func TestOpenAPIComponents(t *testing.T) {
+	t.Run("schemas", func(t *testing.T) {
+		schemas, err := apiComponents().Schemas()
+		if err != nil {
+			t.Fatal(err)
+		}
+		signup := schemas["apiSignup"]
+		if got := strings.Join(signup.Required, ","); got != "email,age,note" {
+			t.Errorf("unexpected required: %s", got)
+		}
+		cases := map[string]string{
+			"email":    `{"type":"string","description":"must be a valid email address; must be at most 255 characters","format":"email","minLength":1,"maxLength":255}`,
+			"password": `{"type":"string","description":"must be at least 8 characters","minLength":8}`,
+			"nickname": `{"type":["string","null"]}`,
+			"age":      `{"type":"integer","description":"must be at least 18; must be at most 130","minimum":18,"maximum":130}`,
+			"note":     `{"type":"string"}`,
+			"home":     `{"$ref":"#/components/schemas/apiAddress"}`,
+			"work":     `{"anyOf":[{"$ref":"#/components/schemas/apiAddress"},{"type":"null"}]}`,
+		}
+		for name, want := range cases {
+			if got := schemaJSON(t, signup.Properties[name]); got != want {
+				t.Errorf("%s:\n got: %s\nwant: %s", name, got, want)
+			}
+		}
+		if got := schemaJSON(t, schemas["apiAddress"]); !strings.Contains(got, `"required":["street","zip"]`) {
+			t.Errorf("unexpected address: %s", got)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		c := NewOpenAPIComponents()
+		AddComponent[apiAddress](c)
+		got, err := c.JSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(string(got), "{\n  \"components\": {\n    \"schemas\": {\n      \"apiAddress\": {") {
+			t.Errorf("unexpected document:\n%s", got)
+		}
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		got, err := apiComponents().YAML()
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := `components:
+  schemas:
+    apiAddress:
+      type: object
+      properties:
+        street:
+          type: string
+          minLength: 1
+        zip:
+          type: string
+          description: must be exactly 5 characters
+          minLength: 5
+          maxLength: 5
+      required:
+        - street
+        - zip
+    apiSignup:
+      type: object
+      properties:
+        age:
+          type: integer
+          description: "must be at least 18; must be at most 130"
+          minimum: 18
+          maximum: 130
+        email:
+          type: string
+          description: "must be a valid email address; must be at most 255 characters"
+          format: email
+          minLength: 1
+          maxLength: 255
+        home:
+          "$ref": "#/components/schemas/apiAddress"
+        nickname:
+          type:
+            - string
+            - "null"
+        note:
+          type: string
+        password:
+          type: string
+          description: must be at least 8 characters
+          minLength: 8
+        work:
+          anyOf:
+            - "$ref": "#/components/schemas/apiAddress"
+            - type: "null"
+      required:
+        - email
+        - age
+        - note
+`
+		if string(got) != want {
+			t.Errorf("\n got:\n%s\nwant:\n%s", got, want)
+		}
+	})
+
+	t.Run("names", func(t *testing.T) {
+		c := NewOpenAPIComponents()
+		AddComponent[apiPage[apiAddress]](c)
+		schemas, err := c.Schemas()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := schemas["apiPage_apiAddress"]; !ok {
+			t.Errorf("unexpected names: %v", schemas)
+		}
+
+		// The same type added twice is one component.
+		AddComponent[apiAddress](c)
+		AddComponent[apiAddress](c)
+		if _, err := c.Schemas(); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+
+		type apiAddress struct{}
+		AddComponent[apiAddress](c)
+		if _, err := c.Schemas(); err == nil || !strings.Contains(err.Error(), "is used by") {
+			t.Errorf("expected duplicate name error, got %v", err)
+		}
+
+		c = NewOpenAPIComponents()
+		AddComponent[struct{ Name string }](c)
+		if _, err := c.Schemas(); err == nil || !strings.Contains(err.Error(), "has no name") {
+			t.Errorf("expected unnamed type error, got %v", err)
+		}
+	})
+}
+
+func TestYAMLString(t *testing.T) {
+	cases := map[string]string{
+		"string":            "string",
+		"null":              `"null"`,
+		"Yes":               `"Yes"`,
+		"$ref":              `"$ref"`,
+		"12":                `"12"`,
+		"must be one of: a": `"must be one of: a"`,
+		"a #b":              `"a #b"`,
+		"trailing ":         `"trailing "`,
+		"":                  `""`,
+	}
+	for in, want := range cases {
+		if got := yamlString(in); got != want {
+			t.Errorf("yamlString(%q) = %s, want %s", in, got, want)
+		}
+	}
+}
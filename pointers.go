@@ -4,7 +4,7 @@ package check
 func NotNil[T any](v *T, field string) *Validation {
 	var err error
 	if v == nil {
-		err = fieldErr(field, "must not be nil")
+		err = fieldErr(field, "must not be nil").withKey("required.nil")
 	}
 	return validation(err, field, "required")
 }
@@ -49,8 +49,11 @@ func RequiredPtr[T any](v *T, fn func(T) *Validation, field string) *Validation
 	}
 
 	// Combine required with inner validators
+	inner.resolve()
 	validators := append([]string{"required"}, inner.validators...)
-	return validation(inner.err, field, validators...)
+	combined := validation(inner.err, field, validators...)
+	combined.params = append([]map[string]any{nil}, inner.alignedParams()...)
+	return combined
 }
 
 // RequiredPtrField validates that a pointer is not nil and applies a field-aware validation.
@@ -65,8 +68,11 @@ func RequiredPtrField[T any](v *T, fn func(T, string) *Validation, field string)
 	}
 
 	// Combine required with inner validators
+	inner.resolve()
 	validators := append([]string{"required"}, inner.validators...)
-	return validation(inner.err, field, validators...)
+	combined := validation(inner.err, field, validators...)
+	combined.params = append([]map[string]any{nil}, inner.alignedParams()...)
+	return combined
 }
 
 // DefaultOr uses a default value if the pointer is nil, then validates.
@@ -104,7 +110,7 @@ func Ptr[T any](v T) *T {
 func NotNilInterface(v any, field string) *Validation {
 	var err error
 	if v == nil {
-		err = fieldErr(field, "must not be nil")
+		err = fieldErr(field, "must not be nil").withKey("required.nil")
 	}
 	return validation(err, field, "required")
 }
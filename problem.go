@@ -0,0 +1,193 @@
+package check
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ProblemContentType is the media type of a [Problem] document.
+const ProblemContentType = "application/problem+json"
+
+// Problem is an RFC 9457 problem details document describing a failed validation.
+// Errors lists each failure; Type, Title, Status, Detail and Instance are the
+// standard members and may be adjusted before encoding.
+type Problem struct {
+	Type     string         `json:"type,omitempty"`
+	Title    string         `json:"title"`
+	Status   int            `json:"status,omitempty"`
+	Detail   string         `json:"detail,omitempty"`
+	Instance string         `json:"instance,omitempty"`
+	Errors   []ProblemError `json:"errors"`
+}
+
+// ProblemError is a single entry in a Problem's errors array.
+type ProblemError struct {
+	Pointer string         `json:"pointer"`          // JSON Pointer to the field, e.g. "/items/2/sku"
+	Field   string         `json:"field"`            // Field path as validated, e.g. "items[2].sku"
+	Code    string         `json:"code"`             // Validator name, e.g. "min"
+	Message string         `json:"message"`          // Human-readable description
+	Params  map[string]any `json:"params,omitempty"` // Validator parameters
+}
+
+// Codes used for problem entries that do not come from a validator.
+const (
+	CodeUnchecked   = "unchecked"    // A tagged field was not validated
+	CodeMissingRule = "missing_rule" // A tagged rule was not validated
+	CodeInvalid     = "invalid"      // An error without field information
+)
+
+// NewProblem builds a problem document from a Result, with status 422.
+// Entries are ordered by field, then by the order validations ran, so the
+// output is stable for a given set of validations.
+// Returns nil if the Result passed, since there is no problem to describe.
+func NewProblem(r *Result) *Problem {
+	err := r.Err()
+	if err == nil {
+		return nil
+	}
+	entries := problemErrors(err)
+	p := &Problem{
+		Type:   "about:blank",
+		Title:  "Unprocessable Entity",
+		Status: 422,
+		Errors: entries,
+	}
+	switch len(entries) {
+	case 1:
+		p.Detail = "1 validation error"
+	default:
+		p.Detail = strconv.Itoa(len(entries)) + " validation errors"
+	}
+	return p
+}
+
+// problemErrors converts an error tree into sorted problem entries.
+func problemErrors(err error) []ProblemError {
+	entries := make([]ProblemError, 0)
+	if err == nil {
+		return entries
+	}
+	for _, e := range flatten(err) {
+		entries = append(entries, problemError(e))
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return comparePointers(entries[i].Pointer, entries[j].Pointer) < 0
+	})
+	return entries
+}
+
+// problemError converts a single error into a problem entry.
+func problemError(err error) ProblemError {
+	var fe *FieldError
+	if errors.As(err, &fe) {
+		return ProblemError{
+			Pointer: JSONPointer(fe.Field),
+			Field:   fe.Field,
+			Code:    fe.Code,
+			Message: fe.Message,
+			Params:  fe.Params,
+		}
+	}
+	var ue *UncheckedFieldError
+	if errors.As(err, &ue) {
+		return ProblemError{
+			Pointer: JSONPointer(ue.Field),
+			Field:   ue.Field,
+			Code:    CodeUnchecked,
+			Message: "tagged but not validated",
+			Params:  map[string]any{"tag": ue.Tag},
+		}
+	}
+	var me *MissingRuleError
+	if errors.As(err, &me) {
+		return ProblemError{
+			Pointer: JSONPointer(me.Field),
+			Field:   me.Field,
+			Code:    CodeMissingRule,
+			Message: "rule " + me.Rule + " not validated",
+			Params:  map[string]any{"rule": me.Rule, "tag": me.Tag},
+		}
+	}
+	return ProblemError{Code: CodeInvalid, Message: err.Error()}
+}
+
+// JSONPointer converts a field path to an RFC 6901 JSON Pointer.
+// Dots and brackets become segments: "items[2].sku" is "/items/2/sku" and
+// "labels[env]" is "/labels/env". An empty path is the empty pointer.
+func JSONPointer(field string) string {
+	var b strings.Builder
+	for _, segment := range pathSegments(field) {
+		b.WriteByte('/')
+		b.WriteString(escapePointer(segment))
+	}
+	return b.String()
+}
+
+// pathSegments splits a field path on "." and "[...]".
+func pathSegments(field string) []string {
+	var segments []string
+	for field != "" {
+		switch field[0] {
+		case '.':
+			field = field[1:]
+		case '[':
+			end := strings.IndexByte(field, ']')
+			if end == -1 {
+				return append(segments, field[1:])
+			}
+			segments = append(segments, field[1:end])
+			field = field[end+1:]
+		default:
+			end := strings.IndexAny(field, ".[")
+			if end == -1 {
+				return append(segments, field)
+			}
+			segments = append(segments, field[:end])
+			field = field[end:]
+		}
+	}
+	return segments
+}
+
+// escapePointer escapes "~" and "/" in a pointer segment.
+func escapePointer(segment string) string {
+	segment = strings.ReplaceAll(segment, "~", "~0")
+	return strings.ReplaceAll(segment, "/", "~1")
+}
+
+// comparePointers orders JSON Pointers segment by segment,
+// comparing array indexes numerically so "/items/2" sorts before "/items/10".
+func comparePointers(a, b string) int {
+	as, bs := strings.Split(a, "/"), strings.Split(b, "/")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		if as[i] == bs[i] {
+			continue
+		}
+		ai, aErr := strconv.Atoi(as[i])
+		bi, bErr := strconv.Atoi(bs[i])
+		if aErr == nil && bErr == nil {
+			return ai - bi
+		}
+		return strings.Compare(as[i], bs[i])
+	}
+	return len(as) - len(bs)
+}
+
+// MarshalJSON encodes the error as a problem entry.
+func (e *FieldError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(problemError(e))
+}
+
+// MarshalJSON encodes the errors as a sorted array of problem entries.
+func (e Errors) MarshalJSON() ([]byte, error) {
+	return json.Marshal(problemErrors(e))
+}
+
+// MarshalJSON encodes the Result as a problem document, or as null if it
+// passed. See [NewProblem].
+func (r *Result) MarshalJSON() ([]byte, error) {
+	return json.Marshal(NewProblem(r))
+}
@@ -0,0 +1,171 @@
+package check
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestJSONPointer(t *testing.T) {
+	tests := []struct {
+		field string
+		want  string
+	}{
+		{"", ""},
+		{"email", "/email"},
+		{"address.zip", "/address/zip"},
+		{"items[2].sku", "/items/2/sku"},
+		{"labels[env]", "/labels/env"},
+		{"matrix[1][0]", "/matrix/1/0"},
+		{"paths[a/b~c]", "/paths/a~1b~0c"},
+		{"labels[a.b]", "/labels/a.b"},
+	}
+	for _, tt := range tests {
+		if got := JSONPointer(tt.field); got != tt.want {
+			t.Errorf("JSONPointer(%q) = %q, want %q", tt.field, got, tt.want)
+		}
+	}
+}
+
+func TestNewProblem(t *testing.T) {
+	t.Run("document", func(t *testing.T) {
+		r := All(
+			Str("", "email").Required().V(),
+			Slice([]string{"a"}, "items").MinItems(2).V(),
+			MinLen("ab", 3, "items[10].sku"),
+			MinLen("ab", 3, "items[2].sku"),
+			Str("x", "email").MinLen(3).V(),
+		)
+		data, err := json.Marshal(NewProblem(r))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := `{"type":"about:blank","title":"Unprocessable Entity","status":422,"detail":"5 validation errors","errors":[` +
+			`{"pointer":"/email","field":"email","code":"required","message":"is required"},` +
+			`{"pointer":"/email","field":"email","code":"min","message":"must be at least 3 characters","params":{"min":3}},` +
+			`{"pointer":"/items","field":"items","code":"minitems","message":"must have at least 2 items","params":{"min":2}},` +
+			`{"pointer":"/items/2/sku","field":"items[2].sku","code":"min","message":"must be at least 3 characters","params":{"min":3}},` +
+			`{"pointer":"/items/10/sku","field":"items[10].sku","code":"min","message":"must be at least 3 characters","params":{"min":3}}]}`
+		if string(data) != want {
+			t.Errorf("unexpected document:\n got %s\nwant %s", data, want)
+		}
+	})
+
+	t.Run("passing result", func(t *testing.T) {
+		if p := NewProblem(All(Required("x", "name"))); p != nil {
+			t.Errorf("expected no problem, got %+v", p)
+		}
+	})
+
+	t.Run("nil result", func(t *testing.T) {
+		if p := NewProblem(nil); p != nil {
+			t.Errorf("expected no problem, got %+v", p)
+		}
+	})
+
+	t.Run("single error detail", func(t *testing.T) {
+		if p := NewProblem(All(Required("", "name"))); p.Detail != "1 validation error" {
+			t.Errorf("unexpected detail: %s", p.Detail)
+		}
+	})
+
+	t.Run("unchecked and missing rules", func(t *testing.T) {
+		r := Check[CheckedRequest](
+			Str("a@b.co", "email").Required().V(),
+		)
+		p := NewProblem(r)
+		var codes []string
+		for _, e := range p.Errors {
+			codes = append(codes, e.Pointer+" "+e.Code)
+		}
+		want := []string{"/email missing_rule", "/name unchecked", "/password unchecked"}
+		if len(codes) != len(want) {
+			t.Fatalf("expected %v, got %v", want, codes)
+		}
+		for i := range want {
+			if codes[i] != want[i] {
+				t.Errorf("expected %v, got %v", want, codes)
+			}
+		}
+		if p.Errors[0].Params["rule"] != "email" {
+			t.Errorf("unexpected params: %v", p.Errors[0].Params)
+		}
+	})
+
+	t.Run("non-field errors", func(t *testing.T) {
+		p := NewProblem(All(&Validation{err: errors.New("boom")}))
+		if len(p.Errors) != 1 || p.Errors[0].Code != CodeInvalid || p.Errors[0].Pointer != "" || p.Errors[0].Message != "boom" {
+			t.Errorf("unexpected entry: %+v", p.Errors)
+		}
+	})
+}
+
+func TestMarshalJSON(t *testing.T) {
+	t.Run("FieldError", func(t *testing.T) {
+		data, err := json.Marshal(MaxLen("abcd", 3, "user.name").Err())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := `{"pointer":"/user/name","field":"user.name","code":"max","message":"must be at most 3 characters","params":{"max":3}}`
+		if string(data) != want {
+			t.Errorf("got %s, want %s", data, want)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		errs := Errors{Required("", "b"), Required("", "a")}
+		data, err := json.Marshal(errs)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := `[{"pointer":"/a","field":"a","code":"required","message":"is required"},` +
+			`{"pointer":"/b","field":"b","code":"required","message":"is required"}]`
+		if string(data) != want {
+			t.Errorf("got %s, want %s", data, want)
+		}
+
+		data, _ = json.Marshal(Errors(nil))
+		if string(data) != `[]` {
+			t.Errorf("expected empty array, got %s", data)
+		}
+	})
+
+	t.Run("Result", func(t *testing.T) {
+		r := All(Str("", "name").Required().V())
+		got, err := json.Marshal(r)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want, _ := json.Marshal(NewProblem(r))
+		if string(got) != string(want) {
+			t.Errorf("got %s, want %s", got, want)
+		}
+	})
+
+	t.Run("passing Result", func(t *testing.T) {
+		data, err := json.Marshal(All(Required("x", "name")))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(data) != "null" {
+			t.Errorf("expected null, got %s", data)
+		}
+	})
+
+	t.Run("deterministic", func(t *testing.T) {
+		build := func() string {
+			data, _ := json.Marshal(All(
+				Str("", "z").Required().V(),
+				Str("", "a").Required().MinLen(2).V(),
+				OneOf("c", []string{"a", "b"}, "m"),
+			))
+			return string(data)
+		}
+		first := build()
+		for i := 0; i < 20; i++ {
+			if got := build(); got != first {
+				t.Fatalf("output changed:\n%s\n%s", first, got)
+			}
+		}
+	})
+}
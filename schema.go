@@ -0,0 +1,497 @@
+package check
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/zoobzio/check/internal/tagrules"
+)
+
+// jsonSchemaDialect is the $schema of documents produced by [SchemaFor].
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// JSONSchema is a JSON Schema (draft 2020-12) document, limited to the
+// keywords that validators map to. It encodes with encoding/json.
+type JSONSchema struct {
+	Schema          string `json:"$schema,omitempty"`
+	Ref             string `json:"$ref,omitempty"`
+	Type            string `json:"type,omitempty"`
+	Nullable        bool   `json:"-"` // Encodes type as [Type, "null"]
+	Description     string `json:"description,omitempty"`
+	Format          string `json:"format,omitempty"`
+	ContentEncoding string `json:"contentEncoding,omitempty"`
+	Pattern         string `json:"pattern,omitempty"`
+	Enum            []any  `json:"enum,omitempty"`
+	Const           any    `json:"const,omitempty"`
+
+	MinLength *int `json:"minLength,omitempty"`
+	MaxLength *int `json:"maxLength,omitempty"`
+
+	Minimum          *float64 `json:"minimum,omitempty"`
+	Maximum          *float64 `json:"maximum,omitempty"`
+	ExclusiveMinimum *float64 `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum *float64 `json:"exclusiveMaximum,omitempty"`
+	MultipleOf       *float64 `json:"multipleOf,omitempty"`
+
+	Items       *JSONSchema `json:"items,omitempty"`
+	MinItems    *int        `json:"minItems,omitempty"`
+	MaxItems    *int        `json:"maxItems,omitempty"`
+	UniqueItems bool        `json:"uniqueItems,omitempty"`
+
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties *JSONSchema            `json:"additionalProperties,omitempty"`
+	PropertyNames        *JSONSchema            `json:"propertyNames,omitempty"`
+	MinProperties        *int                   `json:"minProperties,omitempty"`
+	MaxProperties        *int                   `json:"maxProperties,omitempty"`
+
+	Not   *JSONSchema   `json:"not,omitempty"`
+	AllOf []*JSONSchema `json:"allOf,omitempty"`
+	AnyOf []*JSONSchema `json:"anyOf,omitempty"`
+}
+
+// MarshalJSON encodes the schema, with the type of a nullable schema as a
+// list including "null".
+func (s *JSONSchema) MarshalJSON() ([]byte, error) {
+	type plain JSONSchema
+	if !s.Nullable || s.Type == "" {
+		return json.Marshal((*plain)(s))
+	}
+	return json.Marshal(struct {
+		Type []string `json:"type"`
+		*plain
+	}{[]string{s.Type, "null"}, (*plain)(s)})
+}
+
+// SchemaFor derives a JSON Schema for T from its validate tags and from the
+// validators recorded in results, such as the Result of a dry run of T's
+// Validate method. Properties are named as encoding/json names them, and
+// their rules are looked up by validation field name, with the rules recorded
+// for any element, e.g. "items[2].sku", applying to every element.
+//
+// Validators map to keywords by the kind of the field: min and max become
+// minLength and maxLength for strings, minimum and maximum for numbers and
+// minItems and maxItems for slices; email, uuid and url become formats; oneof
+// becomes enum; Match becomes pattern; Unique becomes uniqueItems; required
+// lists the property as required. Validators without a JSON Schema
+// equivalent, such as cross-field rules, are left out.
+//
+// A dry run records only the validators that run, so rules behind When, Bail
+// or nil pointers are described only if the value passed exercises them.
+// Each records element rules under the field itself, so recorded element
+// rules reach the items schema only through Nested; tags describe elements
+// with dive:
+//
+//	var s Signup
+//	schema := check.SchemaFor[Signup](s.Validate())
+func SchemaFor[T any](results ...*Result) *JSONSchema {
+	b := schemaBuilder{recorded: recordedValidators(results)}
+	s, _ := b.schema(reflect.TypeFor[T](), "", "", nil)
+	s.Schema = jsonSchemaDialect
+	return s
+}
+
+// elementPath replaces the indexes and map keys in a field path with "[]",
+// so "items[2].sku" becomes "items[].sku".
+func elementPath(field string) string {
+	var b strings.Builder
+	depth := 0
+	for _, r := range field {
+		switch {
+		case r == '[':
+			if depth == 0 {
+				b.WriteString("[]")
+			}
+			depth++
+		case r == ']':
+			depth--
+		case depth == 0:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// schemaBuilder builds schemas from Go types, tags and recorded validators.
+type schemaBuilder struct {
+	recorded map[string][]AppliedValidator // By element path
+
+	// For OpenAPI components: the component names of types described by
+	// reference, and whether validated values are required, pointers
+	// nullable and rules described.
+	refs    map[reflect.Type]string
+	openAPI bool
+}
+
+var timeType = reflect.TypeFor[time.Time]()
+
+// schema returns the schema of a value of type t at a validation path, with
+// the rules of its validate tag, and whether the value is required.
+// enclosing guards against recursive types.
+func (b schemaBuilder) schema(t reflect.Type, path, tag string, enclosing []reflect.Type) (*JSONSchema, bool) {
+	pointer := false
+	for t.Kind() == reflect.Pointer {
+		t, pointer = t.Elem(), true
+	}
+	rules, elemTag := tagrules.SplitDive(tag)
+
+	s := &JSONSchema{}
+	switch t.Kind() {
+	case reflect.String:
+		s.Type = "string"
+	case reflect.Bool:
+		s.Type = "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		s.Type = "integer"
+	case reflect.Float32, reflect.Float64:
+		s.Type = "number"
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			s.Type, s.ContentEncoding = "string", "base64"
+			break
+		}
+		s.Type = "array"
+		s.Items, _ = b.schema(t.Elem(), path+"[]", elemTag, enclosing)
+	case reflect.Map:
+		s.Type = "object"
+		s.AdditionalProperties, _ = b.schema(t.Elem(), path+"[]", elemTag, enclosing)
+	case reflect.Struct:
+		if t == timeType {
+			s.Type, s.Format = "string", "date-time"
+			break
+		}
+		if name, ok := b.refs[t]; ok && path != "" {
+			s.Ref = componentRef(name)
+			break
+		}
+		s.Type = "object"
+		b.properties(s, t, path, enclosing)
+	}
+
+	required := false
+	var applied []AppliedValidator
+	for _, rule := range rules {
+		if rule.Name == "required" {
+			required = true
+		}
+		applied = append(applied, tagApplied(t, pointer, rule, path)...)
+	}
+	recorded, validated := b.recorded[path]
+	applied = append(applied, recorded...)
+	for _, a := range applied {
+		required = applySchema(s, t, pointer, a) || required
+	}
+	if !b.openAPI {
+		return s, required
+	}
+
+	// Values validated by the builders for values, rather than the Opt
+	// builders for pointers, must be present.
+	required = required || (validated && !pointer)
+	s.Description = describe(t, applied)
+	if pointer && !required {
+		if s.Ref != "" {
+			s = &JSONSchema{AnyOf: []*JSONSchema{{Ref: s.Ref}, {Type: "null"}}, Description: s.Description}
+		} else {
+			s.Nullable = s.Type != ""
+		}
+	}
+	return s, required
+}
+
+// properties adds the exported fields of struct type t to s, with the fields
+// of embedded structs promoted as encoding/json does.
+func (b schemaBuilder) properties(s *JSONSchema, t reflect.Type, path string, enclosing []reflect.Type) {
+	if slices.Contains(enclosing, t) {
+		return
+	}
+	enclosing = append(enclosing, t)
+	if s.Properties == nil {
+		s.Properties = make(map[string]*JSONSchema)
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		jsonTag := sf.Tag.Get("json")
+		jsonName, _, _ := strings.Cut(jsonTag, ",")
+		if jsonName == "-" {
+			continue
+		}
+		field := joinPath(path, tagrules.FieldName(sf.Name, jsonTag))
+		if embedded := sf.Type; sf.Anonymous && jsonName == "" {
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				b.properties(s, embedded, field, enclosing)
+				continue
+			}
+		}
+		if !sf.IsExported() {
+			continue
+		}
+
+		tag := sf.Tag.Get("validate")
+		if tag == "-" {
+			tag = ""
+		}
+		name := jsonName
+		if name == "" {
+			name = sf.Name
+		}
+		prop, required := b.schema(sf.Type, field, tag, enclosing)
+		s.Properties[name] = prop
+		if required {
+			s.Required = append(s.Required, name)
+		}
+	}
+}
+
+// tagApplied dry-runs a tag rule against the zero value of t, returning the
+// validators it applies. Rules without a value validator, such as cross-field
+// rules, apply none, as does required on a pointer, which is checked for nil.
+func tagApplied(t reflect.Type, pointer bool, rule tagRule, field string) []AppliedValidator {
+	if tagModifiers[rule.Name] || tagrules.CrossField[rule.Name] != "" || tagrules.Conditional[rule.Name] != "" ||
+		(rule.Name == "required" && pointer) {
+		return nil
+	}
+	v, err := valueRule(reflect.Zero(t), rule, field)
+	if err != nil || v == nil {
+		return nil
+	}
+	v.resolve()
+	params := v.alignedParams()
+	applied := make([]AppliedValidator, len(v.validators))
+	for i, name := range v.validators {
+		applied[i] = AppliedValidator{Name: name, Params: params[i]}
+	}
+	return applied
+}
+
+// stringFormats map string validators to JSON Schema formats.
+var stringFormats = map[string]string{
+	"email":    "email",
+	"url":      "uri",
+	"uuid":     "uuid",
+	"uuid4":    "uuid",
+	"ipv4":     "ipv4",
+	"ipv6":     "ipv6",
+	"hostname": "hostname",
+}
+
+// stringPatterns map string validators to the patterns they match, where a
+// format does not capture the rule.
+var stringPatterns = map[string]*regexp.Regexp{
+	"uuid4":    uuid4Regex,
+	"hexcolor": hexColorRegex,
+	"semver":   semverRegex,
+	"e164":     e164Regex,
+}
+
+// applySchema adds the keywords of an applied validator to the schema of a
+// value of type t, reporting whether the validator makes the value required.
+// A required pointer must be present, but its value may still be empty.
+func applySchema(s *JSONSchema, t reflect.Type, pointer bool, applied AppliedValidator) bool {
+	p := applied.Params
+	if applied.Name == "required" {
+		nonEmpty := 1
+		switch {
+		case pointer:
+		case t.Kind() == reflect.String && s.MinLength == nil:
+			s.MinLength = &nonEmpty
+		case s.Type == "array" && s.MinItems == nil:
+			s.MinItems = &nonEmpty
+		case t.Kind() == reflect.Map && s.MinProperties == nil:
+			s.MinProperties = &nonEmpty
+		}
+		return true
+	}
+
+	switch {
+	case t.Kind() == reflect.String:
+		applyString(s, applied)
+	case s.Type == "integer" || s.Type == "number":
+		applyNumber(s, applied)
+	case s.Type == "array":
+		switch applied.Name {
+		case "minitems":
+			s.MinItems = intParam(p, "min")
+		case "maxitems":
+			s.MaxItems = intParam(p, "max")
+		case "len":
+			s.MinItems, s.MaxItems = intParam(p, "len"), intParam(p, "len")
+		case "unique":
+			s.UniqueItems = true
+		}
+	case t.Kind() == reflect.Map:
+		switch applied.Name {
+		case "minkeys":
+			s.MinProperties = intParam(p, "min")
+		case "maxkeys":
+			s.MaxProperties = intParam(p, "max")
+		case "len":
+			s.MinProperties, s.MaxProperties = intParam(p, "len"), intParam(p, "len")
+		case "haskey":
+			s.Required = appendUnique(s.Required, fmt.Sprint(p["key"]))
+		case "haskeys":
+			for _, key := range anySlice(p["keys"]) {
+				s.Required = appendUnique(s.Required, fmt.Sprint(key))
+			}
+		case "onlykeys":
+			s.PropertyNames = &JSONSchema{Enum: anySlice(p["allowed"])}
+		}
+	}
+	return false
+}
+
+// applyString adds the keywords of a string validator.
+func applyString(s *JSONSchema, applied AppliedValidator) {
+	p := applied.Params
+	switch applied.Name {
+	case "min":
+		s.MinLength = intParam(p, "min")
+	case "max":
+		s.MaxLength = intParam(p, "max")
+	case "len":
+		s.MinLength, s.MaxLength = intParam(p, "len"), intParam(p, "len")
+	case "pattern":
+		if negated, _ := p["negated"].(bool); negated {
+			addNot(s, &JSONSchema{Pattern: fmt.Sprint(p["pattern"])})
+		} else {
+			addPattern(s, fmt.Sprint(p["pattern"]))
+		}
+	case "prefix":
+		addPattern(s, "^"+regexp.QuoteMeta(fmt.Sprint(p["prefix"])))
+	case "suffix":
+		addPattern(s, regexp.QuoteMeta(fmt.Sprint(p["suffix"]))+"$")
+	case "contains":
+		addPattern(s, regexp.QuoteMeta(fmt.Sprint(p["substring"])))
+	case "excludes":
+		addNot(s, &JSONSchema{Pattern: regexp.QuoteMeta(fmt.Sprint(p["substring"]))})
+	case "oneof":
+		s.Enum = anySlice(p["allowed"])
+	case "notoneof":
+		addNot(s, &JSONSchema{Enum: anySlice(p["disallowed"])})
+	case "eq":
+		s.Const = p["value"]
+	case "ne":
+		addNot(s, &JSONSchema{Const: p["value"]})
+	default:
+		if format, ok := stringFormats[applied.Name]; ok && s.Format == "" {
+			s.Format = format
+		}
+		if pattern, ok := stringPatterns[applied.Name]; ok {
+			addPattern(s, pattern.String())
+		}
+	}
+}
+
+// applyNumber adds the keywords of a numeric validator.
+func applyNumber(s *JSONSchema, applied AppliedValidator) {
+	p := applied.Params
+	switch applied.Name {
+	case "min", "gte":
+		s.Minimum = numberParam(p, "threshold", "min")
+	case "max", "lte":
+		s.Maximum = numberParam(p, "threshold", "max")
+	case "gt":
+		s.ExclusiveMinimum = numberParam(p, "threshold", "min")
+	case "lt":
+		s.ExclusiveMaximum = numberParam(p, "threshold", "max")
+	case "port", "httpstatus":
+		s.Minimum, s.Maximum = numberParam(p, "min"), numberParam(p, "max")
+	case "multipleof":
+		s.MultipleOf = numberParam(p, "divisor")
+	case "even":
+		two := 2.0
+		s.MultipleOf = &two
+	case "oneof":
+		s.Enum = anySlice(p["allowed"])
+	case "notoneof":
+		addNot(s, &JSONSchema{Enum: anySlice(p["disallowed"])})
+	case "eq":
+		s.Const = p["value"]
+	case "ne":
+		addNot(s, &JSONSchema{Const: p["value"]})
+	}
+}
+
+// addPattern adds a pattern to s, in allOf if s already has a different one.
+func addPattern(s *JSONSchema, pattern string) {
+	switch {
+	case s.Pattern == "":
+		s.Pattern = pattern
+	case s.Pattern == pattern:
+	case !slices.ContainsFunc(s.AllOf, func(sub *JSONSchema) bool { return sub.Pattern == pattern }):
+		s.AllOf = append(s.AllOf, &JSONSchema{Pattern: pattern})
+	}
+}
+
+// addNot adds a schema that s must not match, in allOf if s already has one.
+func addNot(s *JSONSchema, not *JSONSchema) {
+	if s.Not == nil {
+		s.Not = not
+		return
+	}
+	if !reflect.DeepEqual(s.Not, not) {
+		s.AllOf = append(s.AllOf, &JSONSchema{Not: not})
+	}
+}
+
+// appendUnique appends name to names if it is not already present.
+func appendUnique(names []string, name string) []string {
+	if slices.Contains(names, name) {
+		return names
+	}
+	return append(names, name)
+}
+
+// numberParam returns the first of keys present in params as a float64, or
+// nil if none is a number.
+func numberParam(params map[string]any, keys ...string) *float64 {
+	for _, key := range keys {
+		v := reflect.ValueOf(params[key])
+		var n float64
+		switch {
+		case v.CanInt():
+			n = float64(v.Int())
+		case v.CanUint():
+			n = float64(v.Uint())
+		case v.CanFloat():
+			n = v.Float()
+		default:
+			continue
+		}
+		return &n
+	}
+	return nil
+}
+
+// intParam returns params[key] as an int, or nil if it is not an integer.
+func intParam(params map[string]any, key string) *int {
+	v := reflect.ValueOf(params[key])
+	if !v.CanInt() {
+		return nil
+	}
+	n := int(v.Int())
+	return &n
+}
+
+// anySlice converts a slice of any element type to []any.
+func anySlice(v any) []any {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil
+	}
+	items := make([]any, rv.Len())
+	for i := range items {
+		items[i] = rv.Index(i).Interface()
+	}
+	return items
+}
@@ -0,0 +1,118 @@
+package check
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+)
+
+type schemaLine struct {
+	SKU string `json:"sku"`
+}
+
+func (l schemaLine) Validate() *Result {
+	return All(Str(l.SKU, "sku").Required().MaxLen(12).V())
+}
+
+type schemaCart struct {
+	Lines []schemaLine `json:"lines"`
+}
+
+func schemaJSON(t *testing.T, s *JSONSchema) string {
+	t.Helper()
+	b, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestSchemaFor(t *testing.T) {
+	t.Run("tags", func(t *testing.T) {
+		got := schemaJSON(t, SchemaFor[tagSignup]().Properties["email"])
+		if want := `{"type":"string","format":"email","minLength":1,"maxLength":255}`; got != want {
+			t.Errorf("email:\n got: %s\nwant: %s", got, want)
+		}
+		cases := map[string]string{
+			"age":     `{"type":"integer","minimum":18,"maximum":130}`,
+			"score":   `{"type":"number","exclusiveMinimum":0,"exclusiveMaximum":1}`,
+			"role":    `{"type":"string","enum":["admin","member"]}`,
+			"website": `{"type":"string","format":"uri"}`,
+			"tags":    `{"type":"array","items":{"type":"string","minLength":2},"maxItems":3}`,
+			"address": `{"type":"object","properties":{"street":{"type":"string","minLength":1},"zip":{"type":"string","minLength":5,"maxLength":5}},"required":["street","zip"]}`,
+		}
+		s := SchemaFor[tagSignup]()
+		for name, want := range cases {
+			if got := schemaJSON(t, s.Properties[name]); got != want {
+				t.Errorf("%s:\n got: %s\nwant: %s", name, got, want)
+			}
+		}
+		if got := schemaJSON(t, &JSONSchema{Required: s.Required}); got != `{"required":["email","password","items"]}` {
+			t.Errorf("unexpected required: %s", got)
+		}
+		if s.Schema != "https://json-schema.org/draft/2020-12/schema" {
+			t.Errorf("unexpected $schema: %s", s.Schema)
+		}
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		type order struct {
+			Code  string         `json:"code"`
+			Count int            `json:"count"`
+			Tags  []string       `json:"tags"`
+			Attrs map[string]int `json:"attrs"`
+			Note  *string        `json:"note"`
+		}
+		var o order
+		r := All(
+			Str(o.Code, "code").Required().Match(regexp.MustCompile(`^[A-Z]{3}$`)).NotMatch(regexp.MustCompile(`^XXX$`)).V(),
+			Num(o.Count, "count").GreaterThan(0).Max(10).V(),
+			StrSlice(o.Tags, "tags").ItemsBetween(1, 5).Unique().V(),
+			HasKey(o.Attrs, "size", "attrs"),
+			RequiredPtr(o.Note, func(v string) *Validation { return MaxLen(v, 100, "note") }, "note"),
+		)
+		want := `{"$schema":"https://json-schema.org/draft/2020-12/schema","type":"object","properties":{` +
+			`"attrs":{"type":"object","required":["size"],"additionalProperties":{"type":"integer"}},` +
+			`"code":{"type":"string","pattern":"^[A-Z]{3}$","minLength":1,"not":{"pattern":"^XXX$"}},` +
+			`"count":{"type":"integer","maximum":10,"exclusiveMinimum":0},` +
+			`"note":{"type":"string"},` +
+			`"tags":{"type":"array","items":{"type":"string"},"minItems":1,"maxItems":5,"uniqueItems":true}},` +
+			`"required":["code","note"]}`
+		if got := schemaJSON(t, SchemaFor[order](r)); got != want {
+			t.Errorf("\n got: %s\nwant: %s", got, want)
+		}
+	})
+
+	t.Run("recorded elements", func(t *testing.T) {
+		c := schemaCart{Lines: []schemaLine{{SKU: "a"}, {SKU: "b"}}}
+		r := All(Slice(c.Lines, "lines").NotEmpty().EachV(Nested[schemaLine]).V())
+		got := schemaJSON(t, SchemaFor[schemaCart](r).Properties["lines"])
+		if want := `{"type":"array","items":{"type":"object","properties":{"sku":{"type":"string","minLength":1,"maxLength":12}},"required":["sku"]},"minItems":1}`; got != want {
+			t.Errorf("\n got: %s\nwant: %s", got, want)
+		}
+	})
+
+	t.Run("json names and embedding", func(t *testing.T) {
+		type base struct {
+			ID string `json:"id" validate:"required,uuid4"`
+		}
+		type node struct {
+			base
+			Name     string  `validate:"max=10"`
+			Secret   string  `json:"-" validate:"required"`
+			Raw      []byte  `json:"raw"`
+			Children []*node `json:"children" validate:"max=3"`
+			internal string  //nolint:unused // unexported fields are not encoded
+		}
+		got := schemaJSON(t, SchemaFor[node]())
+		want := `{"$schema":"https://json-schema.org/draft/2020-12/schema","type":"object","properties":{` +
+			`"Name":{"type":"string","maxLength":10},` +
+			`"children":{"type":"array","items":{"type":"object"},"maxItems":3},` +
+			`"id":{"type":"string","format":"uuid","pattern":"` + uuid4Regex.String() + `","minLength":1},` +
+			`"raw":{"type":"string","contentEncoding":"base64"}},` +
+			`"required":["id"]}`
+		if got != want {
+			t.Errorf("\n got: %s\nwant: %s", got, want)
+		}
+	})
+}
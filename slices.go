@@ -4,7 +4,7 @@ package check
 func NotEmpty[T any](v []T, field string) *Validation {
 	var err error
 	if len(v) == 0 {
-		err = fieldErr(field, "must not be empty")
+		err = fieldErr(field, "must not be empty").withKey("required.empty")
 	}
 	return validation(err, field, "required")
 }
@@ -24,7 +24,7 @@ func MinItems[T any](v []T, minCount int, field string) *Validation {
 	if len(v) < minCount {
 		err = fieldErrf(field, "must have at least %d items", minCount)
 	}
-	return validation(err, field, "minitems")
+	return validation(err, field, "minitems").with("min", minCount)
 }
 
 // MaxItems validates maximum slice length.
@@ -33,16 +33,16 @@ func MaxItems[T any](v []T, maxCount int, field string) *Validation {
 	if len(v) > maxCount {
 		err = fieldErrf(field, "must have at most %d items", maxCount)
 	}
-	return validation(err, field, "maxitems")
+	return validation(err, field, "maxitems").with("max", maxCount)
 }
 
 // ExactItems validates exact slice length.
 func ExactItems[T any](v []T, count int, field string) *Validation {
 	var err error
 	if len(v) != count {
-		err = fieldErrf(field, "must have exactly %d items", count)
+		err = fieldErrf(field, "must have exactly %d items", count).withKey("len.items")
 	}
-	return validation(err, field, "len")
+	return validation(err, field, "len").with("len", count)
 }
 
 // ItemsBetween validates slice length is within a range (inclusive).
@@ -50,9 +50,9 @@ func ItemsBetween[T any](v []T, minCount, maxCount int, field string) *Validatio
 	var err error
 	l := len(v)
 	if l < minCount || l > maxCount {
-		err = fieldErrf(field, "must have between %d and %d items", minCount, maxCount)
+		err = fieldErrf(field, "must have between %d and %d items", minCount, maxCount).withKey("between.items").withCode(rangeCode(l > maxCount, "minitems", "maxitems"))
 	}
-	return validation(err, field, "minitems", "maxitems")
+	return validation(err, field, "minitems", "maxitems").with("min", minCount).with("max", maxCount)
 }
 
 // Unique validates that all elements in a slice are unique.
@@ -80,7 +80,7 @@ func SliceContains[T comparable](v []T, elem T, field string) *Validation {
 		}
 	}
 	if !found {
-		err = fieldErrf(field, "must contain the required element")
+		err = fieldErrf(field, "must contain the required element").withKey("contains.element")
 	}
 	return validation(err, field, "contains")
 }
@@ -90,7 +90,7 @@ func SliceNotContains[T comparable](v []T, elem T, field string) *Validation {
 	var err error
 	for _, item := range v {
 		if item == elem {
-			err = fieldErr(field, "must not contain the forbidden element")
+			err = fieldErr(field, "must not contain the forbidden element").withKey("excludes.element")
 			break
 		}
 	}
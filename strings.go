@@ -20,7 +20,7 @@ func Required(v, field string) *Validation {
 func NotBlank(v, field string) *Validation {
 	var err error
 	if v == "" || strings.TrimSpace(v) == "" {
-		err = fieldErr(field, "must not be blank")
+		err = fieldErr(field, "must not be blank").withKey("required.blank")
 	}
 	return validation(err, field, "required")
 }
@@ -29,18 +29,18 @@ func NotBlank(v, field string) *Validation {
 func MinLen(v string, minLen int, field string) *Validation {
 	var err error
 	if len([]rune(v)) < minLen {
-		err = fieldErrf(field, "must be at least %d characters", minLen)
+		err = fieldErrf(field, "must be at least %d characters", minLen).withKey("min.string")
 	}
-	return validation(err, field, "min")
+	return validation(err, field, "min").with("min", minLen)
 }
 
 // MaxLen validates maximum string length (in runes, not bytes).
 func MaxLen(v string, maxLen int, field string) *Validation {
 	var err error
 	if len([]rune(v)) > maxLen {
-		err = fieldErrf(field, "must be at most %d characters", maxLen)
+		err = fieldErrf(field, "must be at most %d characters", maxLen).withKey("max.string")
 	}
-	return validation(err, field, "max")
+	return validation(err, field, "max").with("max", maxLen)
 }
 
 // Len validates exact string length (in runes, not bytes).
@@ -49,7 +49,7 @@ func Len(v string, exact int, field string) *Validation {
 	if len([]rune(v)) != exact {
 		err = fieldErrf(field, "must be exactly %d characters", exact)
 	}
-	return validation(err, field, "len")
+	return validation(err, field, "len").with("len", exact)
 }
 
 // LenBetween validates string length is within a range (inclusive).
@@ -57,9 +57,9 @@ func LenBetween(v string, minLen, maxLen int, field string) *Validation {
 	var err error
 	length := len([]rune(v))
 	if length < minLen || length > maxLen {
-		err = fieldErrf(field, "must be between %d and %d characters", minLen, maxLen)
+		err = fieldErrf(field, "must be between %d and %d characters", minLen, maxLen).withKey("between.string").withCode(rangeCode(length > maxLen, "min", "max"))
 	}
-	return validation(err, field, "min", "max")
+	return validation(err, field, "min", "max").with("min", minLen).with("max", maxLen)
 }
 
 // Match validates that a string matches a regular expression.
@@ -68,16 +68,16 @@ func Match(v string, pattern *regexp.Regexp, field string) *Validation {
 	if !pattern.MatchString(v) {
 		err = fieldErrf(field, "must match pattern %s", pattern.String())
 	}
-	return validation(err, field, "pattern")
+	return validation(err, field, "pattern").with("pattern", pattern.String())
 }
 
 // NotMatch validates that a string does not match a regular expression.
 func NotMatch(v string, pattern *regexp.Regexp, field string) *Validation {
 	var err error
 	if pattern.MatchString(v) {
-		err = fieldErrf(field, "must not match pattern %s", pattern.String())
+		err = fieldErrf(field, "must not match pattern %s", pattern.String()).withKey("pattern.not")
 	}
-	return validation(err, field, "pattern")
+	return validation(err, field, "pattern").with("pattern", pattern.String()).with("negated", true)
 }
 
 // Prefix validates that a string starts with the given prefix.
@@ -86,7 +86,7 @@ func Prefix(v, prefix, field string) *Validation {
 	if !strings.HasPrefix(v, prefix) {
 		err = fieldErrf(field, "must start with %q", prefix)
 	}
-	return validation(err, field, "prefix")
+	return validation(err, field, "prefix").with("prefix", prefix)
 }
 
 // Suffix validates that a string ends with the given suffix.
@@ -95,7 +95,7 @@ func Suffix(v, suffix, field string) *Validation {
 	if !strings.HasSuffix(v, suffix) {
 		err = fieldErrf(field, "must end with %q", suffix)
 	}
-	return validation(err, field, "suffix")
+	return validation(err, field, "suffix").with("suffix", suffix)
 }
 
 // Contains validates that a string contains the given substring.
@@ -104,7 +104,7 @@ func Contains(v, substr, field string) *Validation {
 	if !strings.Contains(v, substr) {
 		err = fieldErrf(field, "must contain %q", substr)
 	}
-	return validation(err, field, "contains")
+	return validation(err, field, "contains").with("substring", substr)
 }
 
 // NotContains validates that a string does not contain the given substring.
@@ -113,7 +113,7 @@ func NotContains(v, substr, field string) *Validation {
 	if strings.Contains(v, substr) {
 		err = fieldErrf(field, "must not contain %q", substr)
 	}
-	return validation(err, field, "excludes")
+	return validation(err, field, "excludes").with("substring", substr)
 }
 
 // OneOf validates that a string is one of the allowed values.
@@ -129,7 +129,7 @@ func OneOf(v string, allowed []string, field string) *Validation {
 	if !found {
 		err = fieldErrf(field, "must be one of: %s", strings.Join(allowed, ", "))
 	}
-	return validation(err, field, "oneof")
+	return validation(err, field, "oneof").with("allowed", allowed)
 }
 
 // NotOneOf validates that a string is not one of the disallowed values.
@@ -141,7 +141,7 @@ func NotOneOf(v string, disallowed []string, field string) *Validation {
 			break
 		}
 	}
-	return validation(err, field, "notoneof")
+	return validation(err, field, "notoneof").with("disallowed", disallowed)
 }
 
 // Alpha validates that a string contains only ASCII letters.
@@ -229,7 +229,7 @@ func PrintableASCII(v, field string) *Validation {
 	var err error
 	for _, r := range v {
 		if r < 32 || r > 126 {
-			err = fieldErr(field, "must contain only printable ASCII characters")
+			err = fieldErr(field, "must contain only printable ASCII characters").withKey("ascii.printable")
 			break
 		}
 	}
@@ -288,12 +288,12 @@ func SingleLine(v, field string) *Validation {
 func Identifier(v, field string) *Validation {
 	var err error
 	if v == "" {
-		err = fieldErr(field, "must not be empty")
+		err = fieldErr(field, "must not be empty").withKey("identifier.empty")
 	} else {
 		for i, r := range v {
 			if i == 0 {
 				if !unicode.IsLetter(r) && r != '_' {
-					err = fieldErr(field, "must start with a letter or underscore")
+					err = fieldErr(field, "must start with a letter or underscore").withKey("identifier.start")
 					break
 				}
 			} else {
@@ -312,11 +312,11 @@ func Slug(v, field string) *Validation {
 	var err error
 	switch {
 	case v == "":
-		err = fieldErr(field, "must not be empty")
+		err = fieldErr(field, "must not be empty").withKey("slug.empty")
 	case v[0] == '-' || v[len(v)-1] == '-':
-		err = fieldErr(field, "must not start or end with a hyphen")
+		err = fieldErr(field, "must not start or end with a hyphen").withKey("slug.hyphen_edge")
 	case strings.Contains(v, "--"):
-		err = fieldErr(field, "must not contain consecutive hyphens")
+		err = fieldErr(field, "must not contain consecutive hyphens").withKey("slug.hyphen_repeat")
 	default:
 		for _, r := range v {
 			if !isSlugChar(r) {
@@ -0,0 +1,16 @@
+package check
+
+import "github.com/zoobzio/check/internal/tagrules"
+
+// tagRule is a single rule parsed from a validate tag, e.g. "min=8".
+type tagRule = tagrules.Rule
+
+// tagModifiers are tag rules that alter how other rules apply
+// rather than corresponding to a validator.
+var tagModifiers = tagrules.Modifiers
+
+// ruleSatisfied reports whether any of the applied validators satisfies the rule.
+// Alternatives ("a|b") are satisfied by either side or by the combined name.
+func ruleSatisfied(rule tagRule, validators []string) bool {
+	return tagrules.Satisfied(rule.Name, validators)
+}
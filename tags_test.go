@@ -0,0 +1,34 @@
+package check
+
+import (
+	"testing"
+
+	"github.com/zoobzio/check/internal/tagrules"
+)
+
+func TestRuleSatisfied(t *testing.T) {
+	tests := []struct {
+		rule       string
+		validators []string
+		want       bool
+	}{
+		{"required", []string{"required"}, true},
+		{"email", []string{"required", "max"}, false},
+		{"min", []string{"minitems"}, true},
+		{"max", []string{"maxkeys"}, true},
+		{"gte", []string{"min"}, true},
+		{"uuid", []string{"uuid4"}, true},
+		{"uuid4", []string{"uuid"}, false},
+		{"startswith", []string{"prefix"}, true},
+		{"uuid|slug", []string{"slug"}, true},
+		{"uuid|slug", []string{"uuid|slug"}, true},
+		{"uuid|slug", []string{"email"}, false},
+		{"custom", []string{"custom"}, true},
+	}
+	for _, tt := range tests {
+		rule := tagrules.Parse(tt.rule)[0]
+		if got := ruleSatisfied(rule, tt.validators); got != tt.want {
+			t.Errorf("ruleSatisfied(%q, %v) = %v, want %v", tt.rule, tt.validators, got, tt.want)
+		}
+	}
+}
@@ -10,7 +10,7 @@ func Before(v, t time.Time, field string) *Validation {
 	if !v.Before(t) {
 		err = fieldErrf(field, "must be before %s", t.Format(time.RFC3339))
 	}
-	return validation(err, field, "before")
+	return validation(err, field, "before").with("time", t.Format(time.RFC3339))
 }
 
 // After validates that a time is after the given time.
@@ -19,58 +19,82 @@ func After(v, t time.Time, field string) *Validation {
 	if !v.After(t) {
 		err = fieldErrf(field, "must be after %s", t.Format(time.RFC3339))
 	}
-	return validation(err, field, "after")
+	return validation(err, field, "after").with("time", t.Format(time.RFC3339))
 }
 
 // BeforeOrEqual validates that a time is before or equal to the given time.
 func BeforeOrEqual(v, t time.Time, field string) *Validation {
 	var err error
 	if v.After(t) {
-		err = fieldErrf(field, "must be before or equal to %s", t.Format(time.RFC3339))
+		err = fieldErrf(field, "must be before or equal to %s", t.Format(time.RFC3339)).withKey("lte.time")
 	}
-	return validation(err, field, "lte")
+	return validation(err, field, "lte").with("time", t.Format(time.RFC3339))
 }
 
 // AfterOrEqual validates that a time is after or equal to the given time.
 func AfterOrEqual(v, t time.Time, field string) *Validation {
 	var err error
 	if v.Before(t) {
-		err = fieldErrf(field, "must be after or equal to %s", t.Format(time.RFC3339))
+		err = fieldErrf(field, "must be after or equal to %s", t.Format(time.RFC3339)).withKey("gte.time")
 	}
-	return validation(err, field, "gte")
+	return validation(err, field, "gte").with("time", t.Format(time.RFC3339))
 }
 
 // BeforeNow validates that a time is before the current time.
+// The current time comes from the package clock; see [SetClock].
 func BeforeNow(v time.Time, field string) *Validation {
+	return BeforeNowAt(v, currentTime(), field)
+}
+
+// BeforeNowAt is like BeforeNow but relative to the given time instead of the clock.
+func BeforeNowAt(v, now time.Time, field string) *Validation {
 	var err error
-	if !v.Before(time.Now()) {
+	if !v.Before(now) {
 		err = fieldErr(field, "must be in the past")
 	}
 	return validation(err, field, "past")
 }
 
 // AfterNow validates that a time is after the current time.
+// The current time comes from the package clock; see [SetClock].
 func AfterNow(v time.Time, field string) *Validation {
+	return AfterNowAt(v, currentTime(), field)
+}
+
+// AfterNowAt is like AfterNow but relative to the given time instead of the clock.
+func AfterNowAt(v, now time.Time, field string) *Validation {
 	var err error
-	if !v.After(time.Now()) {
+	if !v.After(now) {
 		err = fieldErr(field, "must be in the future")
 	}
 	return validation(err, field, "future")
 }
 
 // BeforeOrEqualNow validates that a time is before or equal to the current time.
+// The current time comes from the package clock; see [SetClock].
 func BeforeOrEqualNow(v time.Time, field string) *Validation {
+	return BeforeOrEqualNowAt(v, currentTime(), field)
+}
+
+// BeforeOrEqualNowAt is like BeforeOrEqualNow but relative to the given time instead of the clock.
+func BeforeOrEqualNowAt(v, now time.Time, field string) *Validation {
 	var err error
-	if v.After(time.Now()) {
+	if v.After(now) {
 		err = fieldErr(field, "must not be in the future")
 	}
 	return validation(err, field, "pastoreq")
 }
 
 // AfterOrEqualNow validates that a time is after or equal to the current time.
+// The current time comes from the package clock; see [SetClock].
 func AfterOrEqualNow(v time.Time, field string) *Validation {
+	return AfterOrEqualNowAt(v, currentTime(), field)
+}
+
+// AfterOrEqualNowAt is like AfterOrEqualNow but relative to the given time instead of the clock.
+func AfterOrEqualNowAt(v, now time.Time, field string) *Validation {
 	var err error
-	if v.Before(time.Now()) {
+	if v.Before(now) {
 		err = fieldErr(field, "must not be in the past")
 	}
 	return validation(err, field, "futureoreq")
@@ -86,28 +110,43 @@ func InFuture(v time.Time, field string) *Validation {
 	return AfterNow(v, field)
 }
 
+// InPastAt is an alias for BeforeNowAt.
+func InPastAt(v, now time.Time, field string) *Validation {
+	return BeforeNowAt(v, now, field)
+}
+
+// InFutureAt is an alias for AfterNowAt.
+func InFutureAt(v, now time.Time, field string) *Validation {
+	return AfterNowAt(v, now, field)
+}
+
 // BetweenTime validates that a time is within a range (inclusive).
 func BetweenTime(v, start, end time.Time, field string) *Validation {
 	var err error
 	if v.Before(start) || v.After(end) {
-		err = fieldErrf(field, "must be between %s and %s", start.Format(time.RFC3339), end.Format(time.RFC3339))
+		err = fieldErrf(field, "must be between %s and %s", start.Format(time.RFC3339), end.Format(time.RFC3339)).withKey("between.time").withCode(rangeCode(v.After(end), "after", "before"))
 	}
-	return validation(err, field, "after", "before")
+	return validation(err, field, "after", "before").with("start", start.Format(time.RFC3339)).with("end", end.Format(time.RFC3339))
 }
 
 // BetweenTimeExclusive validates that a time is within a range (exclusive).
 func BetweenTimeExclusive(v, start, end time.Time, field string) *Validation {
 	var err error
 	if !v.After(start) || !v.Before(end) {
-		err = fieldErrf(field, "must be between %s and %s (exclusive)", start.Format(time.RFC3339), end.Format(time.RFC3339))
+		err = fieldErrf(field, "must be between %s and %s (exclusive)", start.Format(time.RFC3339), end.Format(time.RFC3339)).withKey("between.time_exclusive").withCode(rangeCode(!v.Before(end), "gt", "lt"))
 	}
-	return validation(err, field, "gt", "lt")
+	return validation(err, field, "gt", "lt").with("start", start.Format(time.RFC3339)).with("end", end.Format(time.RFC3339))
 }
 
 // WithinDuration validates that a time is within a duration from now.
+// The current time comes from the package clock; see [SetClock].
 func WithinDuration(v time.Time, d time.Duration, field string) *Validation {
+	return WithinDurationAt(v, d, currentTime(), field)
+}
+
+// WithinDurationAt is like WithinDuration but relative to the given time instead of the clock.
+func WithinDurationAt(v time.Time, d time.Duration, now time.Time, field string) *Validation {
 	var err error
-	now := time.Now()
 	diff := v.Sub(now)
 	if diff < 0 {
 		diff = -diff
@@ -115,7 +154,7 @@ func WithinDuration(v time.Time, d time.Duration, field string) *Validation {
 	if diff > d {
 		err = fieldErrf(field, "must be within %s of now", d)
 	}
-	return validation(err, field, "within")
+	return validation(err, field, "within").with("duration", d.String())
 }
 
 // WithinDurationOf validates that a time is within a duration of a reference time.
@@ -126,9 +165,9 @@ func WithinDurationOf(v time.Time, d time.Duration, ref time.Time, field string)
 		diff = -diff
 	}
 	if diff > d {
-		err = fieldErrf(field, "must be within %s of reference time", d)
+		err = fieldErrf(field, "must be within %s of reference time", d).withKey("within.reference")
 	}
-	return validation(err, field, "within")
+	return validation(err, field, "within").with("duration", d.String()).with("reference", ref.Format(time.RFC3339))
 }
 
 // SameDay validates that a time is on the same day as the reference time.
@@ -168,7 +207,16 @@ func Weekday(v time.Time, day time.Weekday, field string) *Validation {
 	if v.Weekday() != day {
 		err = fieldErrf(field, "must be on a %s", day)
 	}
-	return validation(err, field, "weekday")
+	return validation(err, field, "weekday").with("weekday", day.String())
+}
+
+// weekdayNames returns the names of the given weekdays.
+func weekdayNames(days []time.Weekday) []string {
+	names := make([]string, len(days))
+	for i, day := range days {
+		names[i] = day.String()
+	}
+	return names
 }
 
 // WeekdayIn validates that a time is on one of the specified weekdays.
@@ -183,9 +231,9 @@ func WeekdayIn(v time.Time, days []time.Weekday, field string) *Validation {
 		}
 	}
 	if !found {
-		err = fieldErr(field, "must be on an allowed weekday")
+		err = fieldErr(field, "must be on an allowed weekday").withKey("weekday.in")
 	}
-	return validation(err, field, "weekday")
+	return validation(err, field, "weekday").with("weekdays", weekdayNames(days))
 }
 
 // NotWeekend validates that a time is not on Saturday or Sunday.
@@ -212,7 +260,7 @@ func IsWeekend(v time.Time, field string) *Validation {
 func NotZeroTime(v time.Time, field string) *Validation {
 	var err error
 	if v.IsZero() {
-		err = fieldErr(field, "must not be empty")
+		err = fieldErr(field, "must not be empty").withKey("required.empty")
 	}
 	return validation(err, field, "required")
 }
@@ -230,11 +278,14 @@ func ZeroTime(v time.Time, field string) *Validation {
 func TimeInTimezone(v time.Time, loc *time.Location, field string) *Validation {
 	var err error
 	if loc == nil {
-		err = fieldErr(field, "timezone must be provided")
-	} else if v.Location().String() != loc.String() {
+		// A nil location reads as UTC, so record no timezone rather than a wrong one.
+		err = fieldErr(field, "timezone must be provided").withKey("timezone.missing")
+		return validation(err, field, "timezone")
+	}
+	if v.Location().String() != loc.String() {
 		err = fieldErrf(field, "must be in timezone %s", loc)
 	}
-	return validation(err, field, "timezone")
+	return validation(err, field, "timezone").with("timezone", loc.String())
 }
 
 // DurationMin validates that a duration is at least the minimum.
@@ -243,7 +294,7 @@ func DurationMin(v, minDur time.Duration, field string) *Validation {
 	if v < minDur {
 		err = fieldErrf(field, "must be at least %s", minDur)
 	}
-	return validation(err, field, "min")
+	return validation(err, field, "min").with("min", minDur.String())
 }
 
 // DurationMax validates that a duration is at most the maximum.
@@ -252,32 +303,32 @@ func DurationMax(v, maxDur time.Duration, field string) *Validation {
 	if v > maxDur {
 		err = fieldErrf(field, "must be at most %s", maxDur)
 	}
-	return validation(err, field, "max")
+	return validation(err, field, "max").with("max", maxDur.String())
 }
 
 // DurationBetween validates that a duration is within a range (inclusive).
 func DurationBetween(v, minDur, maxDur time.Duration, field string) *Validation {
 	var err error
 	if v < minDur || v > maxDur {
-		err = fieldErrf(field, "must be between %s and %s", minDur, maxDur)
+		err = fieldErrf(field, "must be between %s and %s", minDur, maxDur).withKey("between").withCode(rangeCode(v > maxDur, "min", "max"))
 	}
-	return validation(err, field, "min", "max")
+	return validation(err, field, "min", "max").with("min", minDur.String()).with("max", maxDur.String())
 }
 
 // DurationPositive validates that a duration is positive.
 func DurationPositive(v time.Duration, field string) *Validation {
 	var err error
 	if v <= 0 {
-		err = fieldErr(field, "must be positive")
+		err = fieldErr(field, "must be positive").withKey("gt.positive")
 	}
-	return validation(err, field, "gt")
+	return validation(err, field, "gt").with("threshold", 0)
 }
 
 // DurationNonNegative validates that a duration is non-negative.
 func DurationNonNegative(v time.Duration, field string) *Validation {
 	var err error
 	if v < 0 {
-		err = fieldErr(field, "must not be negative")
+		err = fieldErr(field, "must not be negative").withKey("gte.nonnegative")
 	}
-	return validation(err, field, "gte")
+	return validation(err, field, "gte").with("threshold", 0)
 }
@@ -223,6 +223,39 @@ func TestNotZeroTime(t *testing.T) {
 	}
 }
 
+func TestTimeInTimezone(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("timezone data unavailable")
+	}
+	now := time.Now()
+
+	if v := TimeInTimezone(now.In(ny), ny, "field"); v.Failed() {
+		t.Errorf("expected pass, got: %v", v.err)
+	}
+	if v := TimeInTimezone(now.UTC(), ny, "field"); !v.Failed() {
+		t.Error("expected failure for a different timezone")
+	}
+	applied := All(TimeInTimezone(now, ny, "field")).AppliedValidators("field")
+	if len(applied) != 1 || applied[0].Params["timezone"] != "America/New_York" {
+		t.Errorf("expected the timezone param, got %v", applied)
+	}
+
+	t.Run("nil location", func(t *testing.T) {
+		v := TimeInTimezone(now, nil, "field")
+		if !v.Failed() {
+			t.Error("expected failure for a nil location")
+		}
+		applied := All(v).AppliedValidators("field")
+		if len(applied) != 1 || applied[0].Name != "timezone" {
+			t.Fatalf("expected the timezone validator, got %v", applied)
+		}
+		if _, ok := applied[0].Params["timezone"]; ok {
+			t.Errorf("expected no timezone param, got %v", applied[0].Params)
+		}
+	})
+}
+
 func TestDurationMin(t *testing.T) {
 	tests := []struct {
 		value   time.Duration
@@ -274,4 +307,10 @@ func TestDurationPositive(t *testing.T) {
 			t.Errorf("DurationPositive(%v) failed = %v, wantErr %v", tt.value, v.Failed(), tt.wantErr)
 		}
 	}
+
+	for _, v := range []*Validation{DurationPositive(time.Second, "field"), DurationNonNegative(0, "field")} {
+		if applied := All(v).AppliedValidators("field"); len(applied) != 1 || applied[0].Params["threshold"] != 0 {
+			t.Errorf("expected a threshold param, got %v", applied)
+		}
+	}
 }